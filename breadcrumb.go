@@ -0,0 +1,135 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// Breadcrumb is an error annotated with the step of a computation that
+// produced it, and optionally with the source location where the failure was
+// created. Breadcrumbs wrap their cause, so errors.Is and errors.As keep
+// working through any number of them.
+type Breadcrumb struct {
+	// Step describes the computation step that failed. It is empty for a bare
+	// location captured by FailHere.
+	Step string
+
+	// File and Line locate the call to FailHere that created the failure. They
+	// are empty when no location was captured.
+	File string
+	Line int
+
+	// Err is the wrapped cause. A nil cause is rendered as "<nil>".
+	Err error
+}
+
+// Error returns the step followed by the message of the wrapped error, in the
+// same form as fmt.Errorf("%s: %w", step, err).
+func (b *Breadcrumb) Error() string {
+	cause := "<nil>"
+	if b.Err != nil {
+		cause = b.Err.Error()
+	}
+	if b.Step == "" {
+		return cause
+	}
+	return b.Step + ": " + cause
+}
+
+// Unwrap returns the wrapped cause.
+func (b *Breadcrumb) Unwrap() error {
+	return b.Err
+}
+
+// Format implements fmt.Formatter. When formatted with %+v, the breadcrumb chain
+// is printed as rendered by Trace.
+func (b *Breadcrumb) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		_, _ = fmt.Fprint(f, Trace(b))
+	case verb == 'q':
+		_, _ = fmt.Fprintf(f, "%q", b.Error())
+	default:
+		_, _ = fmt.Fprint(f, b.Error())
+	}
+}
+
+// FailHere creates a failure like Fail, additionally recording the file and
+// line of its caller so that Trace can point at the origin of the error.
+func FailHere[T any](err error) Result[T, error] {
+	b := &Breadcrumb{Err: err}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		b.File, b.Line = file, line
+	}
+	return Fail[T, error](b)
+}
+
+// Named annotates the failure of r with the given step name. Successes are
+// returned unchanged.
+func Named[T any](r Result[T, error], step string) Result[T, error] {
+	if r.Success() {
+		return r
+	}
+	return Fail[T, error](&Breadcrumb{Step: step, Err: r.Error()})
+}
+
+// Context annotates the failure of r with a step description built from format
+// and args, as with fmt.Sprintf. Successes are returned unchanged.
+func Context[T any](r Result[T, error], format string, args ...any) Result[T, error] {
+	if r.Success() {
+		return r
+	}
+	return Named(r, fmt.Sprintf(format, args...))
+}
+
+// NamedIO returns an IO that performs i and annotates its failure with the
+// given step name.
+func NamedIO[T any](i IO[T, error], step string) IO[T, error] {
+	return NewIO(func() Result[T, error] {
+		return Named(i.Perform(), step)
+	})
+}
+
+// ContextIO returns an IO that performs i and annotates its failure with a step
+// description built from format and args.
+func ContextIO[T any](i IO[T, error], format string, args ...any) IO[T, error] {
+	return NewIO(func() Result[T, error] {
+		return Context(i.Perform(), format, args...)
+	})
+}
+
+// Trace renders the breadcrumb chain of err: the full error message on the
+// first line, followed by one line per Breadcrumb found while unwrapping err,
+// from the outermost step to the innermost one. Errors wrapping several errors,
+// such as those built by errors.Join, are walked depth first, in order.
+func Trace(err error) string {
+	if err == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(err.Error())
+	traceBreadcrumbs(&sb, err)
+	return sb.String()
+}
+
+// traceBreadcrumbs writes one line per Breadcrumb found in the tree of errors
+// wrapped by err.
+func traceBreadcrumbs(sb *strings.Builder, err error) {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if b, ok := e.(*Breadcrumb); ok {
+			if b.File != "" {
+				fmt.Fprintf(sb, "\n\tat %s:%d", b.File, b.Line)
+			} else {
+				fmt.Fprintf(sb, "\n\tat %s", b.Step)
+			}
+		}
+		if multi, ok := e.(interface{ Unwrap() []error }); ok {
+			for _, inner := range multi.Unwrap() {
+				traceBreadcrumbs(sb, inner)
+			}
+			return
+		}
+	}
+}
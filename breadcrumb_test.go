@@ -0,0 +1,103 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreadcrumbNamed(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	leaf := errors.New("leaf")
+	res := Named(
+		Succeed[int, error](1).FlatMap(func(x int) Result[int, error] {
+			return Named(Fail[int](leaf), "parse")
+		}),
+		"load config",
+	)
+
+	is.True(res.Failure())
+	is.Equal("load config: parse: leaf", res.Error().Error())
+	is.ErrorIs(res.Error(), leaf)
+
+	var b *Breadcrumb
+	is.ErrorAs(res.Error(), &b)
+	is.Equal("load config", b.Step)
+
+	// Successes are left untouched.
+	is.Equal(Succeed[int, error](1), Named(Succeed[int, error](1), "noop"))
+}
+
+func TestBreadcrumbContext(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	res := Context(Fail[int](fs.ErrNotExist), "open %q", "a.txt")
+	is.Equal(`open "a.txt": file does not exist`, res.Error().Error())
+	is.ErrorIs(res.Error(), fs.ErrNotExist)
+}
+
+func TestBreadcrumbIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	leaf := errors.New("leaf")
+	io := NamedIO(
+		ContextIO(NewIO(func() Result[int, error] { return Fail[int](leaf) }), "step %d", 2),
+		"pipeline",
+	)
+
+	res := io.Perform()
+	is.Equal("pipeline: step 2: leaf", res.Error().Error())
+	is.ErrorIs(res.Error(), leaf)
+}
+
+func TestTrace(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	leaf := errors.New("leaf")
+	res := Named(Named(FailHere[int](leaf), "parse"), "load config")
+	wrapped := fmt.Errorf("main: %w", res.Error())
+
+	lines := strings.Split(Trace(wrapped), "\n")
+	is.Len(lines, 4)
+	is.Equal("main: load config: parse: leaf", lines[0])
+	is.Equal("\tat load config", lines[1])
+	is.Equal("\tat parse", lines[2])
+	is.True(strings.HasPrefix(lines[3], "\tat "))
+	is.Contains(lines[3], "breadcrumb_test.go:")
+
+	is.Equal("leaf", Trace(leaf))
+	is.Empty(Trace(nil))
+}
+
+func TestTraceMultipleWrappedErrors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	first := Named(Fail[int](errors.New("a")), "first").Error()
+	second := Named(Fail[int](errors.New("b")), "second").Error()
+
+	joined := errors.Join(first, second)
+	is.Equal(joined.Error()+"\n\tat first\n\tat second", Trace(joined))
+
+	wrapped := Named(Fail[int](fmt.Errorf("both: %w, %w", first, second)), "outer").Error()
+	is.Equal("outer: both: first: a, second: b\n\tat outer\n\tat first\n\tat second", Trace(wrapped))
+}
+
+func TestBreadcrumbNilCause(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal("<nil>", FailHere[int](nil).Error().Error())
+	is.Equal("parse: <nil>", Named(Fail[int, error](nil), "parse").Error().Error())
+	is.True(strings.HasPrefix(fmt.Sprintf("%+v", FailHere[int](nil).Error()), "<nil>\n\tat "))
+	is.Equal(`"parse: <nil>"`, fmt.Sprintf("%q", Named(Fail[int, error](nil), "parse").Error()))
+}
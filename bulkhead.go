@@ -0,0 +1,140 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBulkheadFull is the failure of the effects rejected by a Bulkhead because
+// too many callers are already waiting for a slot.
+var ErrBulkheadFull = errors.New("monad: bulkhead queue is full")
+
+// BulkheadConfig configures a Bulkhead rejecting effects failing with errors of
+// type E. Zero fields take their documented default.
+type BulkheadConfig[E any] struct {
+	// MaxConcurrent is the number of effects which may run at the same time.
+	// Defaults to 1.
+	MaxConcurrent int
+
+	// MaxQueue is the number of effects which may wait for a slot at the same
+	// time. Further effects are rejected with ErrBulkheadFull. Defaults to 0,
+	// rejecting effects rather than waiting.
+	MaxQueue int
+
+	// RejectError converts the error an effect is rejected with to an E: either
+	// ErrBulkheadFull or the error of its context. Defaults to returning the
+	// error as is, which requires E to be able to hold an error.
+	RejectError func(error) E
+
+	// OnAcquire, if set, is called with the time an effect waited for its slot
+	// and the number of effects running, itself included.
+	OnAcquire func(waited time.Duration, inFlight int)
+
+	// OnRelease, if set, is called with the number of effects still running
+	// once an effect is done.
+	OnRelease func(inFlight int)
+
+	// OnReject, if set, is called with the error an effect is rejected with.
+	OnReject func(err error)
+
+	// Clock tells the time to the Bulkhead, which only uses it to measure
+	// waits. Defaults to SystemClock.
+	Clock Clock
+}
+
+// Bulkhead limits the number of effects running at the same time, so that a
+// slow dependency cannot tie up every goroutine. Effects finding every slot
+// taken wait for one in a queue of bounded size. A Bulkhead is safe for
+// concurrent use.
+type Bulkhead[E any] struct {
+	cfg       BulkheadConfig[E]
+	rejectErr func(error) E
+	slots     chan struct{}
+
+	mu     sync.Mutex
+	queued int // The effects waiting for a slot
+}
+
+// NewBulkhead creates a Bulkhead configured with cfg. It panics if
+// cfg.RejectError is nil and E cannot hold an error.
+func NewBulkhead[E any](cfg BulkheadConfig[E]) *Bulkhead[E] {
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = 1
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = SystemClock()
+	}
+	return &Bulkhead[E]{
+		cfg:       cfg,
+		rejectErr: rejectWith("NewBulkhead", cfg.RejectError),
+		slots:     make(chan struct{}, cfg.MaxConcurrent),
+	}
+}
+
+// InFlight returns the number of effects currently running.
+func (b *Bulkhead[E]) InFlight() int {
+	return len(b.slots)
+}
+
+// acquire takes a slot, waiting for one if they are all taken and the queue is
+// not full.
+func (b *Bulkhead[E]) acquire(ctx context.Context) (func(), error) {
+	select {
+	case b.slots <- struct{}{}:
+		b.acquired(0)
+		return b.release, nil
+	default:
+	}
+
+	b.mu.Lock()
+	if b.queued >= b.cfg.MaxQueue {
+		b.mu.Unlock()
+		return nil, b.rejected(ErrBulkheadFull)
+	}
+	b.queued++
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.queued--
+		b.mu.Unlock()
+	}()
+
+	start := b.cfg.Clock.Now()
+	select {
+	case b.slots <- struct{}{}:
+		b.acquired(b.cfg.Clock.Now().Sub(start))
+		return b.release, nil
+	case <-ctx.Done():
+		return nil, b.rejected(ctx.Err())
+	}
+}
+
+// release frees the slot of an effect which is done.
+func (b *Bulkhead[E]) release() {
+	<-b.slots
+	if b.cfg.OnRelease != nil {
+		b.cfg.OnRelease(len(b.slots))
+	}
+}
+
+// reject converts err to an E.
+func (b *Bulkhead[E]) reject(err error) E {
+	return b.rejectErr(err)
+}
+
+// acquired reports an effect allowed to run to OnAcquire.
+func (b *Bulkhead[E]) acquired(waited time.Duration) {
+	if b.cfg.OnAcquire != nil {
+		b.cfg.OnAcquire(waited, len(b.slots))
+	}
+}
+
+// rejected reports a rejected effect to OnReject, and returns err.
+func (b *Bulkhead[E]) rejected(err error) error {
+	if b.cfg.OnReject != nil {
+		b.cfg.OnReject(err)
+	}
+	return err
+}
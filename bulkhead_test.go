@@ -0,0 +1,117 @@
+package monad
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkheadLimitsConcurrency(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var mu sync.Mutex
+	var inFlight []int
+	b := NewBulkhead(BulkheadConfig[error]{
+		MaxConcurrent: 2,
+		OnAcquire: func(_ time.Duration, n int) {
+			mu.Lock()
+			defer mu.Unlock()
+			inFlight = append(inFlight, n)
+		},
+		Clock: newFakeClock(),
+	})
+	p := newGatedProbe()
+	io := WrapIO[int](b, p.io())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			io.Perform()
+		}()
+	}
+	p.awaitStart()
+	p.awaitStart()
+	is.Equal(2, b.InFlight())
+	is.ErrorIs(io.Perform().Error(), ErrBulkheadFull)
+
+	p.release()
+	wg.Wait()
+	is.Equal(0, b.InFlight())
+	is.ElementsMatch([]int{1, 2}, inFlight)
+}
+
+func TestBulkheadQueue(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var released []int
+	var mu sync.Mutex
+	b := NewBulkhead(BulkheadConfig[error]{
+		MaxQueue: 1,
+		OnRelease: func(n int) {
+			mu.Lock()
+			defer mu.Unlock()
+			released = append(released, n)
+		},
+		Clock: newFakeClock(),
+	})
+	p := newGatedProbe()
+	io := WrapIO[int](b, p.io())
+
+	results := make(chan Result[int, error], 2)
+	go func() { results <- io.Perform() }()
+	p.awaitStart()
+	go func() { results <- io.Perform() }()
+	is.Eventually(func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return b.queued == 1
+	}, time.Second, time.Millisecond)
+	is.ErrorIs(io.Perform().Error(), ErrBulkheadFull, "the queue is full")
+
+	p.release()
+	p.awaitStart()
+	is.ElementsMatch([]Result[int, error]{Succeed[int, error](1), Succeed[int, error](2)},
+		[]Result[int, error]{<-results, <-results})
+	is.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(released) == 2
+	}, time.Second, time.Millisecond)
+	is.Equal(0, b.InFlight())
+}
+
+func TestBulkheadContinuationCancellation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	b := NewBulkhead(BulkheadConfig[error]{MaxQueue: 1})
+	p := newGatedProbe()
+	go WrapIO[int](b, p.io()).Perform()
+	p.awaitStart()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c := WrapContinuation[int](b, NewContinuation(func(context.Context) Result[int, error] {
+		return Succeed[int, error](1)
+	}))
+	is.ErrorIs(c.Run(ctx).Error(), context.Canceled)
+	p.release()
+}
+
+func TestBulkheadFuture(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	b := NewBulkhead(BulkheadConfig[error]{})
+	f := WrapFuture[int](b, NewFuture(func() Result[int, error] { return Succeed[int, error](1) }))
+	is.Equal(Succeed[int, error](1), f.Await())
+	is.Equal(0, b.InFlight())
+	is.Panics(func() { NewBulkhead(BulkheadConfig[string]{}) })
+}
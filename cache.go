@@ -0,0 +1,243 @@
+package monad
+
+import (
+	"sync"
+	"time"
+)
+
+// MemoizeIO returns an IO performing i once, the first time it is performed,
+// and returning its Result, success or failure, ever after. Concurrent calls to
+// Perform wait for the same performance of i.
+func MemoizeIO[T, E any](i IO[T, E]) IO[T, E] {
+	return newCachedIO(i, cachePolicy{}).io()
+}
+
+// CacheIO returns an IO performing i the first time it is performed, and
+// returning its Result, success or failure, until ttl elapses. It then
+// performs i again the next time it is performed. A nil clock stands for
+// SystemClock, and a ttl which is not positive never expires.
+func CacheIO[T, E any](i IO[T, E], ttl time.Duration, clock Clock) IO[T, E] {
+	return newCachedIO(i, cachePolicy{ttl: ttl, clock: clock}).io()
+}
+
+// CacheSuccessOnly is like CacheIO, except that failures are not cached: i is
+// performed again until it succeeds.
+func CacheSuccessOnly[T, E any](i IO[T, E], ttl time.Duration, clock Clock) IO[T, E] {
+	return newCachedIO(i, cachePolicy{ttl: ttl, successOnly: true, clock: clock}).io()
+}
+
+// RefreshAhead is like CacheSuccessOnly, except that once the cached value is
+// within refresh of its expiry, performing the IO starts performing i again in
+// the background while still returning the cached value. The cached value is
+// replaced if the refresh succeeds, and kept until its expiry otherwise.
+func RefreshAhead[T, E any](i IO[T, E], ttl, refresh time.Duration, clock Clock) IO[T, E] {
+	return newCachedIO(i, cachePolicy{ttl: ttl, successOnly: true, refresh: refresh, clock: clock}).io()
+}
+
+// cachePolicy tells a cachedIO how long to cache which Results.
+type cachePolicy struct {
+	ttl         time.Duration // The time Results are cached for, forever if not positive
+	successOnly bool          // Whether failures are not cached
+	refresh     time.Duration // How long before expiry to refresh in the background
+	clock       Clock
+}
+
+// cachedIO caches the Result of an IO according to its policy. At most one
+// performance of the IO is in flight at any time.
+type cachedIO[T, E any] struct {
+	action func() Result[T, E]
+	policy cachePolicy
+
+	mu      sync.Mutex
+	cached  bool         // Whether result holds a cached Result
+	result  Result[T, E] // The cached Result
+	expires time.Time    // When the cached Result expires
+	loading Future[T, E] // The performance in flight, if any
+}
+
+func newCachedIO[T, E any](i IO[T, E], policy cachePolicy) *cachedIO[T, E] {
+	if policy.clock == nil {
+		policy.clock = SystemClock()
+	}
+	return &cachedIO[T, E]{action: i.Perform, policy: policy}
+}
+
+// io returns the IO performing the cached IO.
+func (c *cachedIO[T, E]) io() IO[T, E] {
+	return NewIO(c.perform)
+}
+
+// perform returns the cached Result if it is still valid, and otherwise waits
+// for a new performance of the IO, starting one if none is in flight.
+func (c *cachedIO[T, E]) perform() Result[T, E] {
+	c.mu.Lock()
+	now := c.policy.clock.Now()
+	if c.cached && (c.policy.ttl <= 0 || now.Before(c.expires)) {
+		res := c.result
+		refreshing := c.policy.refresh > 0 && c.policy.ttl > 0 && !now.Before(c.expires.Add(-c.policy.refresh))
+		if refreshing && c.loading == nil {
+			go awaitRefresh(c.load())
+		}
+		c.mu.Unlock()
+		return res
+	}
+	loading := c.load()
+	c.mu.Unlock()
+	return loading.Await()
+}
+
+// load returns the performance in flight, starting one if needed. It must be
+// called with the lock held.
+func (c *cachedIO[T, E]) load() Future[T, E] {
+	if c.loading == nil {
+		c.loading = NewFuture(c.reload)
+	}
+	return c.loading
+}
+
+// awaitRefresh awaits a refresh started in the background. A panicking refresh
+// is recovered here and treated like a failed one, keeping the cached value,
+// while Perform calls waiting for the same refresh still re-raise the panic.
+func awaitRefresh[T, E any](refresh Future[T, E]) {
+	defer func() { _ = recover() }()
+	refresh.Await()
+}
+
+// expired tells whether the cached IO holds no valid Result at now, and has no
+// performance in flight.
+func (c *cachedIO[T, E]) expired(now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	valid := c.cached && (c.policy.ttl <= 0 || now.Before(c.expires))
+	return !valid && c.loading == nil
+}
+
+// reload performs the IO and caches its Result according to the policy.
+func (c *cachedIO[T, E]) reload() (res Result[T, E]) {
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.loading = nil
+	}()
+	res = c.action()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if res.Success() || !c.policy.successOnly {
+		c.cached, c.result = true, res
+		c.expires = c.policy.clock.Now().Add(c.policy.ttl)
+	}
+	return res
+}
+
+// Cache caches the values loaded for each key by a function returning an IO.
+// Concurrent loads of the same key are deduplicated: they wait for a single
+// performance of the IO. Only successes are cached. A Cache is safe for
+// concurrent use.
+//
+// Expired entries are evicted as the Cache grows, so that it holds at most
+// about twice as many entries as there are valid ones. A key whose load fails
+// is evicted at once. Purge evicts every expired entry.
+type Cache[K comparable, V, E any] struct {
+	load   func(K) IO[V, E]
+	policy cachePolicy
+
+	mu        sync.Mutex
+	entries   map[K]*cachedIO[V, E]
+	nextPurge int // The number of entries at which to purge expired ones
+}
+
+// minPurge is the number of entries below which a Cache never purges them on
+// its own.
+const minPurge = 64
+
+// NewCache creates a Cache loading values with load, and caching them for ttl.
+// A nil clock stands for SystemClock, and a ttl which is not positive never
+// expires.
+func NewCache[K comparable, V, E any](load func(K) IO[V, E], ttl time.Duration, clock Clock) *Cache[K, V, E] {
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &Cache[K, V, E]{
+		load:      load,
+		policy:    cachePolicy{ttl: ttl, successOnly: true, clock: clock},
+		entries:   map[K]*cachedIO[V, E]{},
+		nextPurge: minPurge,
+	}
+}
+
+// Get returns an IO returning the cached value for key, loading it if it is
+// missing or expired.
+func (c *Cache[K, V, E]) Get(key K) IO[V, E] {
+	return NewIO(func() Result[V, E] {
+		e := c.entry(key)
+		res := e.perform()
+		if res.Failure() {
+			c.evict(key, e)
+		}
+		return res
+	})
+}
+
+// Invalidate removes the value cached for key, so that it is loaded again
+// when next needed. A load in flight is not cancelled.
+func (c *Cache[K, V, E]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// Purge evicts the entries which expired, except those being loaded.
+func (c *Cache[K, V, E]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purge()
+}
+
+// Len returns the number of keys the Cache holds an entry for, including
+// expired ones which were not evicted yet.
+func (c *Cache[K, V, E]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// entry returns the cached IO of key, creating it if needed.
+func (c *Cache[K, V, E]) entry(key K) *cachedIO[V, E] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		if len(c.entries) >= c.nextPurge {
+			c.purge()
+			c.nextPurge = 2 * len(c.entries)
+			if c.nextPurge < minPurge {
+				c.nextPurge = minPurge
+			}
+		}
+		e = newCachedIO(NewIO(func() Result[V, E] { return c.load(key).Perform() }), c.policy)
+		c.entries[key] = e
+	}
+	return e
+}
+
+// evict removes the entry e of key if it expired. It leaves the entry which
+// replaced e, if any.
+func (c *Cache[K, V, E]) evict(key K, e *cachedIO[V, E]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] == e && e.expired(c.policy.clock.Now()) {
+		delete(c.entries, key)
+	}
+}
+
+// purge removes the entries which expired. It must be called with the lock
+// held.
+func (c *Cache[K, V, E]) purge() {
+	now := c.policy.clock.Now()
+	for key, e := range c.entries {
+		if e.expired(now) {
+			delete(c.entries, key)
+		}
+	}
+}
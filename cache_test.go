@@ -0,0 +1,209 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoizeIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	p := newProbe()
+	io := MemoizeIO(p.io(1))
+	is.Equal(0, p.count())
+	is.Equal(Fail[int](errors.New("failure 1")), io.Perform())
+	is.Equal(Fail[int](errors.New("failure 1")), io.Perform(), "failures are cached too")
+	is.Equal(1, p.count())
+}
+
+func TestMemoizeIOIsConcurrencySafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	p := newGatedProbe()
+	io := MemoizeIO(p.io())
+
+	var wg sync.WaitGroup
+	results := make([]Result[int, error], 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = io.Perform()
+		}(i)
+	}
+	p.release()
+	wg.Wait()
+	for _, r := range results {
+		is.Equal(Succeed[int, error](1), r)
+	}
+	is.Equal(1, p.count())
+}
+
+func TestCacheIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	p := newProbe()
+	io := CacheIO(p.io(1), time.Minute, clock)
+
+	is.Equal(Fail[int](errors.New("failure 1")), io.Perform())
+	clock.Advance(59 * time.Second)
+	is.Equal(Fail[int](errors.New("failure 1")), io.Perform())
+	clock.Advance(time.Second)
+	is.Equal(Succeed[int, error](2), io.Perform())
+	is.Equal(Succeed[int, error](2), io.Perform())
+	is.Equal(2, p.count())
+}
+
+func TestCacheSuccessOnly(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	p := newProbe()
+	io := CacheSuccessOnly(p.io(1), time.Minute, clock)
+
+	is.Equal(Fail[int](errors.New("failure 1")), io.Perform())
+	is.Equal(Succeed[int, error](2), io.Perform())
+	is.Equal(Succeed[int, error](2), io.Perform())
+	clock.Advance(time.Minute)
+	is.Equal(Succeed[int, error](3), io.Perform())
+}
+
+func TestRefreshAhead(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	p := newProbe()
+	io := RefreshAhead(p.io(2), time.Minute, 10*time.Second, clock)
+
+	is.Equal(Succeed[int, error](1), io.Perform())
+	clock.Advance(50 * time.Second)
+	is.Equal(Succeed[int, error](1), io.Perform(), "the cached value is returned while refreshing")
+	is.Eventually(func() bool { return p.count() == 2 }, time.Second, time.Millisecond)
+	is.Equal(Succeed[int, error](1), io.Perform(), "a failed refresh keeps the cached value")
+
+	is.Eventually(func() bool {
+		io.Perform()
+		return p.count() == 3
+	}, time.Second, time.Millisecond)
+	is.Eventually(func() bool {
+		return io.Perform() == Succeed[int, error](3)
+	}, time.Second, time.Millisecond)
+	clock.Advance(49 * time.Second)
+	is.Equal(Succeed[int, error](3), io.Perform(), "a successful refresh extends the expiry")
+}
+
+func TestRefreshAheadStartsOneRefresh(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	var performed atomic.Int32
+	release := make(chan struct{})
+	io := RefreshAhead(NewIO(func() Result[int32, error] {
+		n := performed.Add(1)
+		if n > 1 {
+			<-release
+			panic("boom")
+		}
+		return Succeed[int32, error](n)
+	}), time.Minute, 10*time.Second, clock)
+
+	is.Equal(Succeed[int32, error](1), io.Perform())
+	clock.Advance(50 * time.Second)
+	for i := 0; i < 10; i++ {
+		is.Equal(Succeed[int32, error](1), io.Perform())
+	}
+	is.Eventually(func() bool { return performed.Load() == 2 }, time.Second, time.Millisecond)
+	close(release)
+
+	is.Eventually(func() bool {
+		return io.Perform() == Succeed[int32, error](1) && performed.Load() >= 3
+	}, time.Second, time.Millisecond, "a panicking refresh keeps the cached value, and is retried")
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	var mu sync.Mutex
+	loads := map[string]int{}
+	release := make(chan struct{})
+	cache := NewCache(func(key string) IO[string, error] {
+		return NewIO(func() Result[string, error] {
+			<-release
+			mu.Lock()
+			defer mu.Unlock()
+			loads[key]++
+			return Succeed[string, error](fmt.Sprint(key, loads[key]))
+		})
+	}, time.Minute, clock)
+
+	var wg sync.WaitGroup
+	results := make([]Result[string, error], 6)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = cache.Get([]string{"a", "b"}[i%2]).Perform()
+		}(i)
+	}
+	is.Eventually(func() bool { return cache.Len() == 2 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, r := range results {
+		is.Equal(Succeed[string, error]([]string{"a1", "b1"}[i%2]), r)
+	}
+	is.Equal(map[string]int{"a": 1, "b": 1}, loads, "concurrent loads of a key are deduplicated")
+
+	cache.Invalidate("a")
+	is.Equal(Succeed[string, error]("a2"), cache.Get("a").Perform())
+	clock.Advance(time.Minute)
+	is.Equal(Succeed[string, error]("b2"), cache.Get("b").Perform())
+}
+
+func TestCacheEviction(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	cache := NewCache(func(key int) IO[int, error] {
+		return NewIO(func() Result[int, error] {
+			if key < 0 {
+				return Fail[int](errTest)
+			}
+			return Succeed[int, error](key)
+		})
+	}, time.Minute, clock)
+
+	is.Equal(Fail[int](errTest), cache.Get(-1).Perform())
+	is.Zero(cache.Len(), "a key whose load fails is evicted")
+
+	cache.Get(1).Perform()
+	cache.Get(2).Perform()
+	clock.Advance(30 * time.Second)
+	cache.Get(3).Perform()
+	clock.Advance(30 * time.Second)
+	is.Equal(3, cache.Len())
+	cache.Purge()
+	is.Equal(1, cache.Len(), "only the entry which did not expire is kept")
+
+	for i := 0; i < 10*minPurge; i++ {
+		cache.Get(100 + i).Perform()
+		clock.Advance(time.Minute)
+	}
+	is.LessOrEqual(cache.Len(), minPurge, "expired entries are evicted as the cache grows")
+}
@@ -0,0 +1,130 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+)
+
+// ErrChannelClosed is the failure of a Future created by FutureFromChannel
+// whose channel is closed before receiving a value.
+var ErrChannelClosed = errors.New("monad: channel closed")
+
+// FutureFromChannel creates a Future receiving its value from ch when awaited.
+// It fails with ErrChannelClosed if ch is closed without receiving a value.
+func FutureFromChannel[T any](ch <-chan T) Future[T, error] {
+	return NewFuture(func() Result[T, error] {
+		v, ok := <-ch
+		if !ok {
+			return Fail[T](ErrChannelClosed)
+		}
+		return Succeed[T, error](v)
+	})
+}
+
+// ResultsFromChannel merges a channel of values and a channel of errors, as
+// commonly returned by channel-based APIs, into a channel of Results. Values
+// become successes and errors failures, in the order they are received. The
+// returned channel is closed once both channels are, a nil channel counting as
+// closed, or once ctx is done, which stops the forwarding goroutine when the
+// consumer stops receiving.
+func ResultsFromChannel[T any](
+	ctx context.Context, values <-chan T, errs <-chan error,
+) <-chan Result[T, error] {
+	out := make(chan Result[T, error])
+	go func() {
+		defer close(out)
+		for values != nil || errs != nil {
+			var r Result[T, error]
+			select {
+			case v, ok := <-values:
+				if !ok {
+					values = nil
+					continue
+				}
+				r = Succeed[T, error](v)
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				r = Fail[T](err)
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// CollectChannel creates a Future receiving every value of ch until it is
+// closed, once awaited. It fails with the error of ctx if ctx is done first.
+func CollectChannel[T any](ctx context.Context, ch <-chan T) Future[[]T, error] {
+	return NewFuture(func() Result[[]T, error] {
+		values := []T{}
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return Succeed[[]T, error](values)
+				}
+				values = append(values, v)
+			case <-ctx.Done():
+				return Fail[[]T](ctx.Err())
+			}
+		}
+	})
+}
+
+// SendIO creates an IO sending v on ch, blocking until it is received. It
+// succeeds with v, or with nothing if ch is closed.
+func SendIO[T any](ch chan<- T, v T) IO[Maybe[T], error] {
+	return NewIO(func() (res Result[Maybe[T], error]) {
+		defer func() {
+			if r := recover(); r != nil {
+				if err, ok := r.(runtime.Error); !ok || err.Error() != "send on closed channel" {
+					panic(r)
+				}
+				res = Succeed[Maybe[T], error](None[T]())
+			}
+		}()
+		ch <- v
+		return Succeed[Maybe[T], error](Some(v))
+	})
+}
+
+// RecvIO creates an IO receiving a value from ch, blocking until one is sent.
+// It succeeds with the value, or with nothing if ch is closed.
+func RecvIO[T any](ch <-chan T) IO[Maybe[T], error] {
+	return NewIO(func() Result[Maybe[T], error] {
+		v, ok := <-ch
+		if !ok {
+			return Succeed[Maybe[T], error](None[T]())
+		}
+		return Succeed[Maybe[T], error](Some(v))
+	})
+}
+
+// Select awaits futures concurrently, returning the index and the Result of
+// the first one to complete. The other futures keep running. It panics if
+// futures is empty, and re-raises the panic of the first future to panic.
+func Select[T, E any](futures ...Future[T, E]) (int, Result[T, E]) {
+	if len(futures) == 0 {
+		panic("monad: Select: no futures")
+	}
+	cases := make([]reflect.SelectCase, len(futures))
+	for i, f := range futures {
+		cases[i] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(f.Channel())}
+	}
+	i, v, ok := reflect.Select(cases)
+	if !ok {
+		return i, futures[i].Await()
+	}
+	return i, v.Interface().(Result[T, E])
+}
@@ -0,0 +1,133 @@
+package monad
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFutureChannel(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ch := NewFuture(func() Result[int, error] { return Succeed[int, error](42) }).Channel()
+	is.Equal(Succeed[int, error](42), <-ch)
+	_, open := <-ch
+	is.False(open)
+
+	panicking := NewFuture(func() Result[int, error] { panic("boom") })
+	r, open := <-panicking.Channel()
+	is.False(open, "a panicking Future closes its channel without a Result")
+	is.Nil(r)
+	is.PanicsWithError("panic: boom", func() { panicking.Await() })
+}
+
+func TestFutureFromChannel(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ch := make(chan int, 1)
+	f := FutureFromChannel(ch)
+	ch <- 42
+	is.Equal(Succeed[int, error](42), f.Await())
+
+	closed := make(chan int)
+	close(closed)
+	is.ErrorIs(FutureFromChannel(closed).Await().Error(), ErrChannelClosed)
+}
+
+func TestResultsFromChannel(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	values, errs := make(chan int), make(chan error)
+	go func() {
+		values <- 1
+		errs <- errTest
+		values <- 2
+		close(values)
+		close(errs)
+	}()
+
+	var got []Result[int, error]
+	for r := range ResultsFromChannel(context.Background(), values, errs) {
+		got = append(got, r)
+	}
+	is.Equal([]Result[int, error]{Succeed[int, error](1), Fail[int](errTest), Succeed[int, error](2)}, got)
+
+	only := make(chan int, 1)
+	only <- 3
+	close(only)
+	is.Equal(Succeed[int, error](3), <-ResultsFromChannel(context.Background(), only, nil))
+}
+
+func TestResultsFromChannelCancellation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	values := make(chan int, 1)
+	values <- 1
+	out := ResultsFromChannel(ctx, values, nil)
+	cancel()
+
+	received := 0
+	for range out {
+		received++
+	}
+	is.LessOrEqual(received, 1, "the channel is closed once ctx is done, while values stays open")
+}
+
+func TestCollectChannel(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "b"
+	close(ch)
+	is.Equal(Succeed[[]string, error]([]string{"a", "b"}), CollectChannel(context.Background(), ch).Await())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	is.ErrorIs(CollectChannel(ctx, make(chan string)).Await().Error(), context.Canceled)
+}
+
+func TestSendAndRecvIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ch := make(chan int, 1)
+	is.Equal(Succeed[Maybe[int], error](Some(1)), SendIO(ch, 1).Perform())
+	is.Equal(Succeed[Maybe[int], error](Some(1)), RecvIO(ch).Perform())
+
+	close(ch)
+	is.True(SendIO(ch, 2).Perform().Value().Nothing())
+	is.True(RecvIO(ch).Perform().Value().Nothing())
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	release := make(chan struct{})
+	slow := NewFuture(func() Result[string, error] {
+		<-release
+		return Succeed[string, error]("slow")
+	})
+	fast := NewFuture(func() Result[string, error] {
+		time.Sleep(time.Millisecond)
+		return Succeed[string, error]("fast")
+	})
+	i, r := Select(slow, fast)
+	is.Equal(1, i)
+	is.Equal(Succeed[string, error]("fast"), r)
+	close(release)
+
+	is.Panics(func() { Select[int, error]() })
+	is.Panics(func() {
+		Select(NewFuture(func() Result[int, error] { panic("boom") }))
+	})
+}
@@ -0,0 +1,327 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is the failure of the IOs and Futures short-circuited by an
+// open CircuitBreaker.
+var ErrCircuitOpen = errors.New("monad: circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through, counting failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen short-circuits every call until the reset timeout elapses.
+	CircuitOpen
+
+	// CircuitHalfOpen lets a limited number of probe calls through to decide
+	// whether to close the circuit again.
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// CircuitBreakerConfig configures a CircuitBreaker guarding calls failing with
+// errors of type E. Zero fields take their documented default.
+type CircuitBreakerConfig[E any] struct {
+	// FailureThreshold is the number of failures within Window opening the
+	// circuit. Defaults to 5.
+	FailureThreshold int
+
+	// FailureRatio, if positive, additionally requires the failures to make up
+	// at least this ratio of the calls within Window for the circuit to open.
+	FailureRatio float64
+
+	// Window is the duration of the rolling window over which failures are
+	// counted. Defaults to one minute.
+	Window time.Duration
+
+	// WindowBuckets is the number of intervals Window is divided into. Calls
+	// are counted per interval, and forgotten once their interval falls out of
+	// the window, so that the window is precise to one interval. Defaults to
+	// 10.
+	WindowBuckets int
+
+	// HalfOpenProbes is the number of probe calls let through when half-open,
+	// all of which must succeed for the circuit to close. Defaults to 1.
+	HalfOpenProbes int
+
+	// ResetTimeout is the time the circuit stays open before turning
+	// half-open. Defaults to 30 seconds.
+	ResetTimeout time.Duration
+
+	// IsFailure tells whether an error counts as a failure of the guarded
+	// dependency. Defaults to counting every error.
+	IsFailure func(E) bool
+
+	// OpenError builds the failure of short-circuited calls. Defaults to
+	// ErrCircuitOpen, which requires E to be able to hold an error.
+	OpenError func() E
+
+	// OnStateChange, if set, is called after every state transition. It is
+	// called without holding the lock of the CircuitBreaker, and may thus use
+	// it.
+	OnStateChange func(from, to CircuitState)
+
+	// Clock tells the time to the CircuitBreaker. Defaults to SystemClock.
+	Clock Clock
+}
+
+// CircuitBreaker stops calling a failing dependency for a while, failing fast
+// instead. Closed at first, it opens when the failures within its rolling
+// window reach the threshold. Once the reset timeout elapses, it turns
+// half-open and lets a few probe calls through: the circuit closes if they all
+// succeed, and opens again otherwise.
+//
+// Wrap IOs and Futures with ProtectIO and ProtectFuture. A CircuitBreaker is
+// safe for concurrent use.
+type CircuitBreaker[E any] struct {
+	cfg CircuitBreakerConfig[E]
+
+	mu         sync.Mutex
+	state      CircuitState
+	generation int           // Incremented on every transition
+	width      time.Duration // The duration of the intervals of the window
+	buckets    []bucket      // Ring of the outcomes of the calls per interval, when closed
+	interval   int64         // The latest interval calls were counted in
+	calls      int           // The calls within the window, when closed
+	failures   int           // The failed calls within the window, when closed
+	openedAt   time.Time     // When the circuit last opened
+	probes     int           // The probe calls let through, when half-open
+	successes  int           // The successful probe calls, when half-open
+}
+
+// bucket counts the outcomes of the calls made during an interval of the
+// window while the circuit was closed.
+type bucket struct {
+	calls, failures int
+}
+
+// NewCircuitBreaker creates a closed CircuitBreaker configured with cfg. It
+// panics if cfg.OpenError is nil and E cannot hold ErrCircuitOpen.
+func NewCircuitBreaker[E any](cfg CircuitBreakerConfig[E]) *CircuitBreaker[E] {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = time.Minute
+	}
+	if cfg.WindowBuckets <= 0 {
+		cfg.WindowBuckets = 10
+	}
+	if cfg.HalfOpenProbes <= 0 {
+		cfg.HalfOpenProbes = 1
+	}
+	if cfg.ResetTimeout <= 0 {
+		cfg.ResetTimeout = 30 * time.Second
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = func(E) bool { return true }
+	}
+	if cfg.OpenError == nil {
+		err, ok := any(ErrCircuitOpen).(E)
+		if !ok {
+			panic(fmt.Sprintf("monad: NewCircuitBreaker: %s cannot hold ErrCircuitOpen, set OpenError", typeName[E]()))
+		}
+		cfg.OpenError = func() E { return err }
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = SystemClock()
+	}
+	width := cfg.Window / time.Duration(cfg.WindowBuckets)
+	if width <= 0 {
+		width = 1
+	}
+	return &CircuitBreaker[E]{cfg: cfg, width: width, buckets: make([]bucket, cfg.WindowBuckets)}
+}
+
+// State returns the current state of the circuit. An open circuit whose reset
+// timeout has elapsed is reported as half-open.
+func (cb *CircuitBreaker[E]) State() CircuitState {
+	cb.mu.Lock()
+	changes := cb.refresh()
+	state := cb.state
+	cb.mu.Unlock()
+	cb.notify(changes)
+	return state
+}
+
+// ProtectIO returns an IO performing i through cb. It fails with the open error
+// of cb without performing i while the circuit is open, and reports the
+// outcome of i to cb otherwise.
+func ProtectIO[T, E any](cb *CircuitBreaker[E], i IO[T, E]) IO[T, E] {
+	return NewIO(func() Result[T, E] {
+		return protect(cb, i.Perform)
+	})
+}
+
+// ProtectFuture returns a Future awaiting f through cb. It fails with the open
+// error of cb without awaiting f while the circuit is open, and reports the
+// outcome of f to cb otherwise.
+func ProtectFuture[T, E any](cb *CircuitBreaker[E], f Future[T, E]) Future[T, E] {
+	return NewFuture(func() Result[T, E] {
+		return protect(cb, f.Await)
+	})
+}
+
+// protect runs action if cb lets the call through, reporting its outcome.
+// A panicking action counts as a failure.
+func protect[T, E any](cb *CircuitBreaker[E], action func() Result[T, E]) Result[T, E] {
+	generation, ok := cb.acquire()
+	if !ok {
+		return Fail[T](cb.cfg.OpenError())
+	}
+	failed := true
+	defer func() { cb.release(generation, failed) }()
+	res := action()
+	failed = res.Failure() && cb.cfg.IsFailure(res.Error())
+	return res
+}
+
+// stateChange is a transition to notify to OnStateChange.
+type stateChange struct {
+	from, to CircuitState
+}
+
+// acquire tells whether a call may go through, along with the generation of
+// the state it was let through in.
+func (cb *CircuitBreaker[E]) acquire() (int, bool) {
+	cb.mu.Lock()
+	changes := cb.refresh()
+	ok := true
+	switch cb.state {
+	case CircuitOpen:
+		ok = false
+	case CircuitHalfOpen:
+		ok = cb.probes < cb.cfg.HalfOpenProbes
+		if ok {
+			cb.probes++
+		}
+	}
+	generation := cb.generation
+	cb.mu.Unlock()
+	cb.notify(changes)
+	return generation, ok
+}
+
+// release records the outcome of a call. Outcomes of calls let through in a
+// previous state are ignored.
+func (cb *CircuitBreaker[E]) release(generation int, failed bool) {
+	cb.mu.Lock()
+	var changes []stateChange
+	if generation == cb.generation {
+		changes = cb.record(failed)
+	}
+	cb.mu.Unlock()
+	cb.notify(changes)
+}
+
+// record updates the state with the outcome of a call. It must be called with
+// the lock held.
+func (cb *CircuitBreaker[E]) record(failed bool) []stateChange {
+	now := cb.cfg.Clock.Now()
+	switch cb.state {
+	case CircuitClosed:
+		b := cb.advance(now)
+		b.calls++
+		cb.calls++
+		if failed {
+			b.failures++
+			cb.failures++
+		}
+		if cb.tripped() {
+			return []stateChange{cb.transition(CircuitOpen, now)}
+		}
+	case CircuitHalfOpen:
+		if failed {
+			return []stateChange{cb.transition(CircuitOpen, now)}
+		}
+		cb.successes++
+		if cb.successes == cb.cfg.HalfOpenProbes {
+			return []stateChange{cb.transition(CircuitClosed, now)}
+		}
+	}
+	return nil
+}
+
+// refresh turns an open circuit half-open once its reset timeout has elapsed.
+// It must be called with the lock held.
+func (cb *CircuitBreaker[E]) refresh() []stateChange {
+	now := cb.cfg.Clock.Now()
+	if cb.state == CircuitOpen && !now.Before(cb.openedAt.Add(cb.cfg.ResetTimeout)) {
+		return []stateChange{cb.transition(CircuitHalfOpen, now)}
+	}
+	return nil
+}
+
+// advance moves the window to the interval of now, forgetting the calls of the
+// intervals which fell out of it, and returns the bucket of now.
+func (cb *CircuitBreaker[E]) advance(now time.Time) *bucket {
+	n := int64(len(cb.buckets))
+	if interval := now.UnixNano() / int64(cb.width); interval > cb.interval {
+		steps := interval - cb.interval
+		if steps > n {
+			steps = n
+		}
+		for i := int64(1); i <= steps; i++ {
+			b := &cb.buckets[((cb.interval+i)%n+n)%n]
+			cb.calls -= b.calls
+			cb.failures -= b.failures
+			*b = bucket{}
+		}
+		cb.interval = interval
+	}
+	return &cb.buckets[(cb.interval%n+n)%n]
+}
+
+// tripped tells whether the calls within the window should open the circuit.
+func (cb *CircuitBreaker[E]) tripped() bool {
+	if cb.failures < cb.cfg.FailureThreshold {
+		return false
+	}
+	return cb.cfg.FailureRatio <= 0 || float64(cb.failures) >= cb.cfg.FailureRatio*float64(cb.calls)
+}
+
+// transition moves the circuit to the given state, resetting its counters.
+func (cb *CircuitBreaker[E]) transition(to CircuitState, now time.Time) stateChange {
+	change := stateChange{from: cb.state, to: to}
+	cb.state = to
+	cb.generation++
+	cb.calls, cb.failures, cb.probes, cb.successes = 0, 0, 0, 0
+	for i := range cb.buckets {
+		cb.buckets[i] = bucket{}
+	}
+	if to == CircuitOpen {
+		cb.openedAt = now
+	}
+	return change
+}
+
+// notify calls OnStateChange with the given transitions, in order.
+func (cb *CircuitBreaker[E]) notify(changes []stateChange) {
+	if cb.cfg.OnStateChange == nil {
+		return
+	}
+	for _, c := range changes {
+		cb.cfg.OnStateChange(c.from, c.to)
+	}
+}
@@ -0,0 +1,272 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerOpensOnFailures(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	var changes []string
+	cb := NewCircuitBreaker(CircuitBreakerConfig[error]{
+		FailureThreshold: 3,
+		Window:           time.Minute,
+		ResetTimeout:     10 * time.Second,
+		Clock:            clock,
+		OnStateChange: func(from, to CircuitState) {
+			changes = append(changes, fmt.Sprint(from, "->", to))
+		},
+	})
+
+	p := newProbe()
+	io := ProtectIO(cb, p.failing())
+	for i := 0; i < 3; i++ {
+		is.Equal(Fail[int](errTest), io.Perform())
+	}
+	is.Equal(CircuitOpen, cb.State())
+
+	r := io.Perform()
+	is.ErrorIs(r.Error(), ErrCircuitOpen)
+	is.Equal(3, p.count(), "an open circuit does not perform the IO")
+	is.Equal([]string{"closed->open"}, changes)
+}
+
+func TestCircuitBreakerWindow(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	cb := NewCircuitBreaker(CircuitBreakerConfig[error]{FailureThreshold: 2, Window: time.Minute, Clock: clock})
+
+	p := newProbe()
+	io := ProtectIO(cb, p.failing())
+	io.Perform()
+	clock.Advance(2 * time.Minute)
+	io.Perform()
+	is.Equal(CircuitClosed, cb.State(), "the first failure fell out of the window")
+	io.Perform()
+	is.Equal(CircuitOpen, cb.State())
+}
+
+func TestCircuitBreakerWindowBuckets(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	cb := NewCircuitBreaker(CircuitBreakerConfig[error]{
+		FailureThreshold: 3,
+		Window:           time.Minute,
+		WindowBuckets:    6,
+		Clock:            clock,
+	})
+	ok := ProtectIO(cb, NewIO(func() Result[int, error] { return Succeed[int, error](1) }))
+	p := newProbe()
+	ko := ProtectIO(cb, p.failing())
+
+	for i := 0; i < 1000; i++ {
+		ok.Perform()
+	}
+	is.Len(cb.buckets, 6, "the outcomes are counted per interval, not stored per call")
+
+	ko.Perform()
+	clock.Advance(30 * time.Second)
+	ko.Perform()
+	clock.Advance(35 * time.Second)
+	ko.Perform()
+	is.Equal(CircuitClosed, cb.State(), "the interval of the first failure fell out of the window")
+	is.Equal(2, cb.failures)
+	is.Equal(2, cb.calls)
+	ko.Perform()
+	is.Equal(CircuitOpen, cb.State())
+}
+
+func TestCircuitBreakerFailureRatio(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig[error]{
+		FailureThreshold: 2,
+		FailureRatio:     0.5,
+		Clock:            newFakeClock(),
+	})
+	ok := ProtectIO(cb, NewIO(func() Result[int, error] { return Succeed[int, error](1) }))
+	p := newProbe()
+	ko := ProtectIO(cb, p.failing())
+
+	ok.Perform()
+	ok.Perform()
+	ok.Perform()
+	ko.Perform()
+	ko.Perform()
+	is.Equal(CircuitClosed, cb.State(), "2 failures out of 5 calls")
+	ko.Perform()
+	is.Equal(CircuitOpen, cb.State(), "3 failures out of 6 calls")
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	t.Parallel()
+
+	newBreaker := func(clock Clock, changes *[]CircuitState) *CircuitBreaker[error] {
+		return NewCircuitBreaker(CircuitBreakerConfig[error]{
+			FailureThreshold: 1,
+			HalfOpenProbes:   2,
+			ResetTimeout:     10 * time.Second,
+			Clock:            clock,
+			OnStateChange:    func(_, to CircuitState) { *changes = append(*changes, to) },
+		})
+	}
+
+	t.Run("successful probes close the circuit", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		clock := newFakeClock()
+		var changes []CircuitState
+		cb := newBreaker(clock, &changes)
+		p := newProbe()
+		ProtectIO(cb, p.failing()).Perform()
+
+		clock.Advance(9 * time.Second)
+		is.Equal(CircuitOpen, cb.State())
+		clock.Advance(time.Second)
+		is.Equal(CircuitHalfOpen, cb.State())
+
+		ok := ProtectIO(cb, NewIO(func() Result[int, error] { return Succeed[int, error](1) }))
+		is.Equal(Succeed[int, error](1), ok.Perform())
+		is.Equal(CircuitHalfOpen, cb.State())
+		is.Equal(Succeed[int, error](1), ok.Perform())
+		is.Equal(CircuitClosed, cb.State())
+		is.Equal([]CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}, changes)
+	})
+
+	t.Run("a failed probe opens the circuit again", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		clock := newFakeClock()
+		var changes []CircuitState
+		cb := newBreaker(clock, &changes)
+		p := newProbe()
+		io := ProtectIO(cb, p.failing())
+		io.Perform()
+		clock.Advance(10 * time.Second)
+		io.Perform()
+		is.Equal(CircuitOpen, cb.State())
+		is.Equal(2, p.count())
+		is.Equal([]CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen}, changes)
+	})
+
+	t.Run("probes are limited", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		clock := newFakeClock()
+		var changes []CircuitState
+		cb := newBreaker(clock, &changes)
+		p := newProbe()
+		ProtectIO(cb, p.failing()).Perform()
+		clock.Advance(10 * time.Second)
+
+		gated := newGatedProbe()
+		blocked := ProtectIO(cb, gated.io())
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				blocked.Perform()
+			}()
+		}
+		gated.awaitStart()
+		gated.awaitStart()
+		is.ErrorIs(ProtectIO(cb, p.failing()).Perform().Error(), ErrCircuitOpen)
+		gated.release()
+		wg.Wait()
+		is.Equal(CircuitClosed, cb.State())
+	})
+}
+
+func TestCircuitBreakerFuture(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	cb := NewCircuitBreaker(CircuitBreakerConfig[error]{FailureThreshold: 1, Clock: newFakeClock()})
+	awaited := 0
+	f := func() Future[int, error] {
+		return NewFuture(func() Result[int, error] {
+			awaited++
+			return Fail[int](errTest)
+		})
+	}
+	is.ErrorIs(ProtectFuture(cb, f()).Await().Error(), errTest)
+	is.ErrorIs(ProtectFuture(cb, f()).Await().Error(), ErrCircuitOpen)
+	is.Equal(1, awaited)
+}
+
+func TestCircuitBreakerOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IsFailure", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		cb := NewCircuitBreaker(CircuitBreakerConfig[error]{
+			FailureThreshold: 1,
+			IsFailure:        func(err error) bool { return !errors.Is(err, errTest) },
+			Clock:            newFakeClock(),
+		})
+		p := newProbe()
+		ProtectIO(cb, p.failing()).Perform()
+		is.Equal(CircuitClosed, cb.State())
+	})
+
+	t.Run("OpenError", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		cb := NewCircuitBreaker(CircuitBreakerConfig[string]{
+			FailureThreshold: 1,
+			OpenError:        func() string { return "open" },
+			Clock:            newFakeClock(),
+		})
+		io := ProtectIO(cb, NewIO(func() Result[int, string] { return Fail[int]("down") }))
+		is.Equal(Fail[int]("down"), io.Perform())
+		is.Equal(Fail[int]("open"), io.Perform())
+	})
+
+	t.Run("OpenError is required when E cannot hold an error", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Panics(func() { NewCircuitBreaker(CircuitBreakerConfig[string]{}) })
+	})
+
+	t.Run("panics count as failures", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		cb := NewCircuitBreaker(CircuitBreakerConfig[error]{FailureThreshold: 1, Clock: newFakeClock()})
+		io := RecoverIO(ProtectIO(cb, NewIO(func() Result[int, error] { panic("boom") })))
+		var panicErr *PanicError
+		is.ErrorAs(io.Perform().Error(), &panicErr)
+		is.Equal(CircuitOpen, cb.State())
+	})
+}
+
+func TestCircuitStateString(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal("closed", CircuitClosed.String())
+	is.Equal("open", CircuitOpen.String())
+	is.Equal("half-open", CircuitHalfOpen.String())
+	is.Equal("CircuitState(7)", CircuitState(7).String())
+}
@@ -0,0 +1,57 @@
+package monad
+
+import "time"
+
+// Clock tells the time to the time-based combinators of this package, such as
+// CircuitBreaker. Replacing the system clock with a fake one makes them
+// deterministic in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a Timer firing once d has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock.
+type Timer interface {
+	// C returns the channel receiving the current time when the timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing, releasing it. It returns false if
+	// the timer already fired or was stopped.
+	Stop() bool
+}
+
+// systemClock is the Clock reading the system time.
+type systemClock struct{}
+
+// SystemClock returns the Clock reading the system time.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer returns a Timer wrapping time.NewTimer(d).
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+// systemTimer is the Timer of the system clock.
+type systemTimer struct {
+	timer *time.Timer
+}
+
+// C returns the channel of the timer.
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+// Stop stops the timer.
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
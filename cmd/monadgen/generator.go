@@ -0,0 +1,402 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// annotation marks an interface as a sum type. It is followed by the names of
+// the variant types, in the order Match takes their handlers.
+const annotation = "//monadgen:sum"
+
+// monadImport is the import path of the monad package used by generated code.
+const monadImport = "github.com/denisdubochevalier/monad"
+
+// sumType describes an annotated interface and its variants.
+type sumType struct {
+	Package       string
+	Name          string
+	Discriminator string
+	Markers       []string
+	Variants      []variant
+	// Imports lists the import specs needed by the types of the fields.
+	Imports []string
+}
+
+// variant describes one of the struct types of a sum type.
+type variant struct {
+	// Type is the name of the struct type.
+	Type string
+	// Name is the exported form of Type, used in generated identifiers.
+	Name string
+	// Tag is the value of the discriminator field identifying the variant.
+	Tag    string
+	Fields []field
+}
+
+// field describes a field of a variant, which becomes a constructor parameter.
+type field struct {
+	Name  string
+	Param string
+	Type  string
+}
+
+// parseSumType parses the non-test Go files of dir and returns the sum type
+// declared by the interface named typeName.
+func parseSumType(dir, typeName, discriminator string) (sumType, error) {
+	fset := token.NewFileSet()
+	files, err := parseDir(fset, dir)
+	if err != nil {
+		return sumType{}, err
+	}
+
+	st := sumType{Name: typeName, Discriminator: discriminator}
+	structs := map[string]*ast.StructType{}
+	structFiles := map[string]*ast.File{}
+	var variantNames []string
+	for _, file := range files {
+		st.Package = file.Name.Name
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					structs[ts.Name.Name] = t
+					structFiles[ts.Name.Name] = file
+				case *ast.InterfaceType:
+					if ts.Name.Name != typeName {
+						continue
+					}
+					if variantNames, err = variantsOf(gen, ts); err != nil {
+						return sumType{}, err
+					}
+					if st.Markers, err = markersOf(t); err != nil {
+						return sumType{}, err
+					}
+				}
+			}
+		}
+	}
+	if variantNames == nil {
+		return sumType{}, fmt.Errorf("no interface %s annotated with %s found in %s", typeName, annotation, dir)
+	}
+
+	imports := map[string]bool{}
+	for _, name := range variantNames {
+		s, ok := structs[name]
+		if !ok {
+			return sumType{}, fmt.Errorf("variant %s of %s is not a struct type declared in %s", name, typeName, dir)
+		}
+		v := variant{Type: name, Name: exported(name), Tag: unexported(name)}
+		for _, f := range s.Fields.List {
+			typ, err := render(fset, f.Type)
+			if err != nil {
+				return sumType{}, err
+			}
+			for _, spec := range importsOf(structFiles[name], f.Type) {
+				imports[spec] = true
+			}
+			for _, n := range fieldNames(f) {
+				if n == "Discriminator" || strings.EqualFold(jsonName(n, f.Tag), discriminator) {
+					return sumType{}, fmt.Errorf(
+						"field %s of variant %s clashes with the discriminator %q", n, name, discriminator,
+					)
+				}
+				v.Fields = append(v.Fields, field{Name: n, Param: param(n), Type: typ})
+			}
+		}
+		st.Variants = append(st.Variants, v)
+	}
+	for spec := range imports {
+		if spec == `"encoding/json"` || spec == `"fmt"` || spec == strconv.Quote(monadImport) {
+			continue
+		}
+		st.Imports = append(st.Imports, spec)
+	}
+	sort.Strings(st.Imports)
+	return st, nil
+}
+
+// parseDir parses the non-test Go files of dir, skipping generated sum type
+// files so that the generator can be run again.
+func parseDir(fset *token.FileSet, dir string) ([]*ast.File, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_sum.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// variantsOf returns the variant names listed by the annotation of an
+// interface declaration.
+func variantsOf(gen *ast.GenDecl, ts *ast.TypeSpec) ([]string, error) {
+	docs := []*ast.CommentGroup{ts.Doc, gen.Doc}
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, c := range doc.List {
+			if !strings.HasPrefix(c.Text, annotation) {
+				continue
+			}
+			names := strings.Fields(strings.TrimPrefix(c.Text, annotation))
+			if len(names) == 0 {
+				return nil, fmt.Errorf("%s annotation of %s lists no variants", annotation, ts.Name.Name)
+			}
+			return names, nil
+		}
+	}
+	return nil, fmt.Errorf("interface %s is not annotated with %s", ts.Name.Name, annotation)
+}
+
+// markersOf returns the methods of an interface, which must all be unexported
+// marker methods without parameters nor results sealing the sum type.
+func markersOf(t *ast.InterfaceType) ([]string, error) {
+	var markers []string
+	for _, m := range t.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) != 1 || ast.IsExported(m.Names[0].Name) ||
+			fn.Params.NumFields() != 0 || fn.Results.NumFields() != 0 {
+			return nil, errors.New("sum type interfaces may only declare unexported marker methods")
+		}
+		markers = append(markers, m.Names[0].Name)
+	}
+	return markers, nil
+}
+
+// fieldNames returns the names of a struct field, using the type name for
+// embedded fields.
+func fieldNames(f *ast.Field) []string {
+	if len(f.Names) > 0 {
+		names := make([]string, len(f.Names))
+		for i, n := range f.Names {
+			names[i] = n.Name
+		}
+		return names
+	}
+	typ := f.Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if sel, ok := typ.(*ast.SelectorExpr); ok {
+		return []string{sel.Sel.Name}
+	}
+	return []string{fmt.Sprint(typ)}
+}
+
+// importsOf returns the specs of the imports of file that the type expression
+// typ refers to.
+func importsOf(file *ast.File, typ ast.Expr) []string {
+	var specs []string
+	ast.Inspect(typ, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		for _, imp := range file.Imports {
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			name := path.Base(importPath)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			if name == pkg.Name {
+				spec := imp.Path.Value
+				if imp.Name != nil {
+					spec = imp.Name.Name + " " + spec
+				}
+				specs = append(specs, spec)
+			}
+		}
+		return false
+	})
+	return specs
+}
+
+// jsonName returns the name under which encoding/json encodes the field name
+// with the given tag.
+func jsonName(name string, tag *ast.BasicLit) string {
+	if tag == nil {
+		return name
+	}
+	value, _ := strconv.Unquote(tag.Value)
+	jsonTag, _, _ := strings.Cut(reflect.StructTag(value).Get("json"), ",")
+	if jsonTag == "" {
+		return name
+	}
+	return jsonTag
+}
+
+// param returns the name of the constructor parameter for the field name,
+// avoiding keywords.
+func param(name string) string {
+	p := unexported(name)
+	if token.IsKeyword(p) {
+		p += "_"
+	}
+	return p
+}
+
+// render prints an expression as Go source.
+func render(fset *token.FileSet, expr ast.Expr) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// exported returns name with its first letter in upper case.
+func exported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// unexported returns name with its first letter in lower case.
+func unexported(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+// generate returns the formatted source of the code generated for st.
+func generate(st sumType) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := sumTemplate.Execute(&buf, st); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// writeSumType generates the code for st into path.
+func writeSumType(path string, st sumType) error {
+	src, err := generate(st)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+var sumTemplate = template.Must(template.New("sum").Parse(`// Code generated by monadgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+{{range .Imports}}	{{.}}
+{{end}}
+	"` + monadImport + `"
+)
+{{$sum := .}}
+{{- range .Variants}}{{$v := .}}
+// New{{.Name}} creates a {{$sum.Name}} holding a {{.Type}} variant.
+func New{{.Name}}({{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Param}} {{$f.Type}}{{end}}) {{$sum.Name}} {
+	return {{.Type}}{ {{- range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.Name}}: {{$f.Param}}{{end -}} }
+}
+{{range $sum.Markers}}
+// {{.}} seals {{$sum.Name}}.
+func ({{$v.Type}}) {{.}}() {}
+{{end}}
+// MarshalJSON encodes the variant as a JSON object whose "{{$sum.Discriminator}}" field
+// is "{{.Tag}}".
+func (v {{.Type}}) MarshalJSON() ([]byte, error) {
+	type fields {{.Type}}
+	return json.Marshal(struct {
+		Discriminator string ` + "`json:\"{{$sum.Discriminator}}\"`" + `
+		fields
+	}{"{{.Tag}}", fields(v)})
+}
+
+// Is{{.Name}} tells whether the {{$sum.Name}} is a {{.Type}} variant.
+func Is{{.Name}}(s {{$sum.Name}}) bool {
+	_, ok := s.({{.Type}})
+	return ok
+}
+
+// As{{.Name}} returns the {{.Type}} variant of the {{$sum.Name}}, or nothing if it
+// holds another variant.
+func As{{.Name}}(s {{$sum.Name}}) monad.Maybe[{{.Type}}] {
+	v, ok := s.({{.Type}})
+	return monad.FromOk(v, ok)
+}
+{{end}}
+// Match{{.Name}} calls the handler matching the variant of the {{.Name}}. A
+// handler must be provided for every variant.
+func Match{{.Name}}[R any](
+	s {{.Name}},
+{{- range .Variants}}
+	on{{.Name}} func({{.Type}}) R,
+{{- end}}
+) R {
+	switch v := s.(type) {
+{{- range .Variants}}
+	case {{.Type}}:
+		return on{{.Name}}(v)
+{{- end}}
+	default:
+		panic(fmt.Sprintf("monadgen: unknown {{.Name}} variant %T", s))
+	}
+}
+
+// Unmarshal{{.Name}} decodes a {{.Name}} encoded by the MarshalJSON method of
+// one of its variants, using its "{{.Discriminator}}" field to pick the variant.
+func Unmarshal{{.Name}}(data []byte) ({{.Name}}, error) {
+	var header struct {
+		Discriminator string ` + "`json:\"{{.Discriminator}}\"`" + `
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	switch header.Discriminator {
+{{- range .Variants}}
+	case "{{.Tag}}":
+		var v {{.Type}}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+{{- end}}
+	default:
+		return nil, fmt.Errorf("unknown {{.Name}} variant %q", header.Discriminator)
+	}
+}
+`))
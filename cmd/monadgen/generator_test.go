@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSumType(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	st, err := parseSumType(filepath.Join("testdata", "payment"), "Payment", "type")
+	is.NoError(err)
+	is.Equal("payment", st.Package)
+	is.Equal([]string{"isPayment"}, st.Markers)
+	is.Equal([]string{`"time"`}, st.Imports)
+	is.Len(st.Variants, 3)
+	is.Equal(variant{
+		Type: "settled",
+		Name: "Settled",
+		Tag:  "settled",
+		Fields: []field{
+			{Name: "Amount", Param: "amount", Type: "int"},
+			{Name: "Reference", Param: "reference", Type: "string"},
+			{Name: "SettledAt", Param: "settledAt", Type: "time.Time"},
+		},
+	}, st.Variants[1])
+}
+
+func TestGenerateMatchesGoldenFile(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	dir := filepath.Join("testdata", "payment")
+	st, err := parseSumType(dir, "Payment", "type")
+	is.NoError(err)
+
+	got, err := generate(st)
+	is.NoError(err)
+	want, err := os.ReadFile(filepath.Join(dir, "payment_sum.go"))
+	is.NoError(err)
+	is.Equal(string(want), string(got))
+}
+
+func TestGeneratedCodeRoundTrips(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	out, err := exec.Command("go", "test", "-count=1", "./testdata/payment").CombinedOutput()
+	is.NoError(err, "the tests of the generated code fail:\n%s", out)
+}
+
+func TestParseSumTypeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "missing interface",
+			src:  "package p\n",
+			want: "no interface Shape annotated with //monadgen:sum found",
+		},
+		{
+			name: "missing annotation",
+			src:  "package p\n\ntype Shape interface{ isShape() }\n",
+			want: "interface Shape is not annotated with //monadgen:sum",
+		},
+		{
+			name: "no variants",
+			src:  "package p\n\n//monadgen:sum\ntype Shape interface{ isShape() }\n",
+			want: "lists no variants",
+		},
+		{
+			name: "exported method",
+			src:  "package p\n\n//monadgen:sum circle\ntype Shape interface{ Area() float64 }\n\ntype circle struct{}\n",
+			want: "only declare unexported marker methods",
+		},
+		{
+			name: "unknown variant",
+			src:  "package p\n\n//monadgen:sum circle\ntype Shape interface{ isShape() }\n",
+			want: "variant circle of Shape is not a struct type",
+		},
+		{
+			name: "discriminator clash",
+			src: "package p\n\n//monadgen:sum circle\ntype Shape interface{ isShape() }\n\n" +
+				"type circle struct{ Kind string `json:\"type\"` }\n",
+			want: `field Kind of variant circle clashes with the discriminator "type"`,
+		},
+		{
+			name: "discriminator field name clash",
+			src: "package p\n\n//monadgen:sum circle\ntype Shape interface{ isShape() }\n\n" +
+				"type circle struct{ Discriminator string }\n",
+			want: `field Discriminator of variant circle clashes with the discriminator "type"`,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			is := require.New(t)
+
+			dir := t.TempDir()
+			is.NoError(os.WriteFile(filepath.Join(dir, "shape.go"), []byte(tc.src), 0o644))
+			_, err := parseSumType(dir, "Shape", "type")
+			is.ErrorContains(err, tc.want)
+		})
+	}
+}
+
+func TestGenerateHandlesEmptyVariantsAndKeywords(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	dir := t.TempDir()
+	src := "package p\n\n//monadgen:sum circle empty\ntype Shape interface{ isShape() }\n\n" +
+		"type circle struct{ Range, Radius float64 }\n\ntype empty struct{}\n"
+	is.NoError(os.WriteFile(filepath.Join(dir, "shape.go"), []byte(src), 0o644))
+
+	st, err := parseSumType(dir, "Shape", "kind")
+	is.NoError(err)
+	out, err := generate(st)
+	is.NoError(err)
+	is.Contains(string(out), "func NewCircle(range_ float64, radius float64) Shape {")
+	is.Contains(string(out), "return circle{Range: range_, Radius: radius}")
+	is.Contains(string(out), "func NewEmpty() Shape {\n\treturn empty{}\n}")
+	is.Contains(string(out), "Discriminator string `json:\"kind\"`")
+}
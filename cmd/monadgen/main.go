@@ -0,0 +1,68 @@
+// Command monadgen generates the boilerplate of sum types declared as sealed
+// interfaces. The interface is annotated with the names of its variants, which
+// are struct types of the same package:
+//
+//	//go:generate monadgen -type Payment
+//
+//	//monadgen:sum pending settled failed
+//	type Payment interface{ isPayment() }
+//
+//	type pending struct{ Amount int }
+//	type settled struct{ Amount int; Reference string }
+//	type failed struct{ Reason string }
+//
+// For each variant, monadgen generates the marker methods sealing the
+// interface, a New<Variant> constructor, Is<Variant> and As<Variant> accessors,
+// the latter returning a monad.Maybe, and a MarshalJSON method adding a
+// discriminator field to the encoded variant. It also generates a
+// Match<Type> function taking one handler per variant, and an
+// Unmarshal<Type> function decoding any variant. Variants cannot have a field
+// named Discriminator, nor a field encoded as the discriminator.
+//
+// Usage:
+//
+//	monadgen -type Name [-output file] [-discriminator field] [dir]
+//
+// The code is written to <name>_sum.go in the package directory, which
+// defaults to the current directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeName := flag.String("type", "", "name of the annotated interface; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_sum.go")
+	discriminator := flag.String("discriminator", "type", "name of the JSON field identifying the variant")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: monadgen -type Name [-output file] [-discriminator field] [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeName == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	if *output == "" {
+		*output = filepath.Join(dir, strings.ToLower(*typeName)+"_sum.go")
+	}
+
+	st, err := parseSumType(dir, *typeName, *discriminator)
+	if err == nil {
+		err = writeSumType(*output, st)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "monadgen:", err)
+		os.Exit(1)
+	}
+}
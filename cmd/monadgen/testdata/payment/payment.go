@@ -0,0 +1,27 @@
+package payment
+
+import "time"
+
+//go:generate go run github.com/denisdubochevalier/monad/cmd/monadgen -type Payment
+
+// Payment is the state of a payment.
+//
+//monadgen:sum pending settled failed
+type Payment interface {
+	isPayment()
+}
+
+type pending struct {
+	Amount int
+}
+
+type settled struct {
+	Amount    int
+	Reference string
+	SettledAt time.Time
+}
+
+type failed struct {
+	Reason string
+	Code   string
+}
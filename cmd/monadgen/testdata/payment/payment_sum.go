@@ -0,0 +1,157 @@
+// Code generated by monadgen; DO NOT EDIT.
+
+package payment
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denisdubochevalier/monad"
+)
+
+// NewPending creates a Payment holding a pending variant.
+func NewPending(amount int) Payment {
+	return pending{Amount: amount}
+}
+
+// isPayment seals Payment.
+func (pending) isPayment() {}
+
+// MarshalJSON encodes the variant as a JSON object whose "type" field
+// is "pending".
+func (v pending) MarshalJSON() ([]byte, error) {
+	type fields pending
+	return json.Marshal(struct {
+		Discriminator string `json:"type"`
+		fields
+	}{"pending", fields(v)})
+}
+
+// IsPending tells whether the Payment is a pending variant.
+func IsPending(s Payment) bool {
+	_, ok := s.(pending)
+	return ok
+}
+
+// AsPending returns the pending variant of the Payment, or nothing if it
+// holds another variant.
+func AsPending(s Payment) monad.Maybe[pending] {
+	v, ok := s.(pending)
+	return monad.FromOk(v, ok)
+}
+
+// NewSettled creates a Payment holding a settled variant.
+func NewSettled(amount int, reference string, settledAt time.Time) Payment {
+	return settled{Amount: amount, Reference: reference, SettledAt: settledAt}
+}
+
+// isPayment seals Payment.
+func (settled) isPayment() {}
+
+// MarshalJSON encodes the variant as a JSON object whose "type" field
+// is "settled".
+func (v settled) MarshalJSON() ([]byte, error) {
+	type fields settled
+	return json.Marshal(struct {
+		Discriminator string `json:"type"`
+		fields
+	}{"settled", fields(v)})
+}
+
+// IsSettled tells whether the Payment is a settled variant.
+func IsSettled(s Payment) bool {
+	_, ok := s.(settled)
+	return ok
+}
+
+// AsSettled returns the settled variant of the Payment, or nothing if it
+// holds another variant.
+func AsSettled(s Payment) monad.Maybe[settled] {
+	v, ok := s.(settled)
+	return monad.FromOk(v, ok)
+}
+
+// NewFailed creates a Payment holding a failed variant.
+func NewFailed(reason string, code string) Payment {
+	return failed{Reason: reason, Code: code}
+}
+
+// isPayment seals Payment.
+func (failed) isPayment() {}
+
+// MarshalJSON encodes the variant as a JSON object whose "type" field
+// is "failed".
+func (v failed) MarshalJSON() ([]byte, error) {
+	type fields failed
+	return json.Marshal(struct {
+		Discriminator string `json:"type"`
+		fields
+	}{"failed", fields(v)})
+}
+
+// IsFailed tells whether the Payment is a failed variant.
+func IsFailed(s Payment) bool {
+	_, ok := s.(failed)
+	return ok
+}
+
+// AsFailed returns the failed variant of the Payment, or nothing if it
+// holds another variant.
+func AsFailed(s Payment) monad.Maybe[failed] {
+	v, ok := s.(failed)
+	return monad.FromOk(v, ok)
+}
+
+// MatchPayment calls the handler matching the variant of the Payment. A
+// handler must be provided for every variant.
+func MatchPayment[R any](
+	s Payment,
+	onPending func(pending) R,
+	onSettled func(settled) R,
+	onFailed func(failed) R,
+) R {
+	switch v := s.(type) {
+	case pending:
+		return onPending(v)
+	case settled:
+		return onSettled(v)
+	case failed:
+		return onFailed(v)
+	default:
+		panic(fmt.Sprintf("monadgen: unknown Payment variant %T", s))
+	}
+}
+
+// UnmarshalPayment decodes a Payment encoded by the MarshalJSON method of
+// one of its variants, using its "type" field to pick the variant.
+func UnmarshalPayment(data []byte) (Payment, error) {
+	var header struct {
+		Discriminator string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+	switch header.Discriminator {
+	case "pending":
+		var v pending
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "settled":
+		var v settled
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "failed":
+		var v failed
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unknown Payment variant %q", header.Discriminator)
+	}
+}
@@ -0,0 +1,70 @@
+package payment
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRoundTrips(t *testing.T) {
+	t.Parallel()
+
+	payments := map[string]Payment{
+		"pending": NewPending(10),
+		"settled": NewSettled(10, "ref", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		"failed":  NewFailed("declined", "E42"),
+	}
+	for tag, p := range payments {
+		tag, p := tag, p
+		t.Run(tag, func(t *testing.T) {
+			t.Parallel()
+			is := require.New(t)
+
+			data, err := json.Marshal(p)
+			is.NoError(err)
+			var header map[string]any
+			is.NoError(json.Unmarshal(data, &header))
+			is.Equal(tag, header["type"])
+
+			decoded, err := UnmarshalPayment(data)
+			is.NoError(err)
+			is.Equal(p, decoded)
+			is.Equal(tag, MatchPayment(
+				decoded,
+				func(pending) string { return "pending" },
+				func(settled) string { return "settled" },
+				func(failed) string { return "failed" },
+			))
+		})
+	}
+}
+
+func TestPaymentAccessors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	p := NewPending(10)
+	is.True(IsPending(p))
+	is.False(IsSettled(p))
+	is.Equal(10, AsPending(p).Value().Amount)
+	is.True(AsFailed(p).Nothing())
+}
+
+func TestUnmarshalPaymentErrors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	p, err := UnmarshalPayment([]byte(`{"type":"refunded"}`))
+	is.EqualError(err, `unknown Payment variant "refunded"`)
+	is.Nil(p)
+
+	p, err = UnmarshalPayment([]byte(`{"type":"pending","Amount":"ten"}`))
+	is.Error(err)
+	is.Nil(p, "no variant is returned along with a decoding error")
+
+	p, err = UnmarshalPayment([]byte(`[]`))
+	is.Error(err)
+	is.Nil(p)
+}
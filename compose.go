@@ -0,0 +1,344 @@
+package monad
+
+import "sync"
+
+// Pipe2 passes a through 2 functions, from left to right.
+func Pipe2[A, B, C any](a A, f1 func(A) B, f2 func(B) C) C {
+	return f2(f1(a))
+}
+
+// Pipe3 passes a through 3 functions, from left to right.
+func Pipe3[A, B, C, D any](a A, f1 func(A) B, f2 func(B) C, f3 func(C) D) D {
+	return f3(f2(f1(a)))
+}
+
+// Pipe4 passes a through 4 functions, from left to right.
+func Pipe4[A, B, C, D, E any](a A, f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) E {
+	return f4(f3(f2(f1(a))))
+}
+
+// Pipe5 passes a through 5 functions, from left to right.
+func Pipe5[A, B, C, D, E, F any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+) F {
+	return f5(f4(f3(f2(f1(a)))))
+}
+
+// Pipe6 passes a through 6 functions, from left to right.
+func Pipe6[A, B, C, D, E, F, G any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+) G {
+	return f6(f5(f4(f3(f2(f1(a))))))
+}
+
+// Pipe7 passes a through 7 functions, from left to right.
+func Pipe7[A, B, C, D, E, F, G, H any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+) H {
+	return f7(f6(f5(f4(f3(f2(f1(a)))))))
+}
+
+// Pipe8 passes a through 8 functions, from left to right.
+func Pipe8[A, B, C, D, E, F, G, H, I any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+) I {
+	return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+}
+
+// Pipe9 passes a through 9 functions, from left to right.
+func Pipe9[A, B, C, D, E, F, G, H, I, J any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+	f9 func(I) J,
+) J {
+	return f9(f8(f7(f6(f5(f4(f3(f2(f1(a)))))))))
+}
+
+// Pipe10 passes a through 10 functions, from left to right.
+func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](
+	a A,
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+	f9 func(I) J,
+	f10 func(J) K,
+) K {
+	return f10(f9(f8(f7(f6(f5(f4(f3(f2(f1(a))))))))))
+}
+
+// Flow2 composes 2 functions, from left to right.
+func Flow2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(a A) C {
+		return Pipe2(a, f1, f2)
+	}
+}
+
+// Flow3 composes 3 functions, from left to right.
+func Flow3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(a A) D {
+		return Pipe3(a, f1, f2, f3)
+	}
+}
+
+// Flow4 composes 4 functions, from left to right.
+func Flow4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(a A) E {
+		return Pipe4(a, f1, f2, f3, f4)
+	}
+}
+
+// Flow5 composes 5 functions, from left to right.
+func Flow5[A, B, C, D, E, F any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+) func(A) F {
+	return func(a A) F {
+		return Pipe5(a, f1, f2, f3, f4, f5)
+	}
+}
+
+// Flow6 composes 6 functions, from left to right.
+func Flow6[A, B, C, D, E, F, G any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+) func(A) G {
+	return func(a A) G {
+		return Pipe6(a, f1, f2, f3, f4, f5, f6)
+	}
+}
+
+// Flow7 composes 7 functions, from left to right.
+func Flow7[A, B, C, D, E, F, G, H any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+) func(A) H {
+	return func(a A) H {
+		return Pipe7(a, f1, f2, f3, f4, f5, f6, f7)
+	}
+}
+
+// Flow8 composes 8 functions, from left to right.
+func Flow8[A, B, C, D, E, F, G, H, I any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+) func(A) I {
+	return func(a A) I {
+		return Pipe8(a, f1, f2, f3, f4, f5, f6, f7, f8)
+	}
+}
+
+// Flow9 composes 9 functions, from left to right.
+func Flow9[A, B, C, D, E, F, G, H, I, J any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+	f9 func(I) J,
+) func(A) J {
+	return func(a A) J {
+		return Pipe9(a, f1, f2, f3, f4, f5, f6, f7, f8, f9)
+	}
+}
+
+// Flow10 composes 10 functions, from left to right.
+func Flow10[A, B, C, D, E, F, G, H, I, J, K any](
+	f1 func(A) B,
+	f2 func(B) C,
+	f3 func(C) D,
+	f4 func(D) E,
+	f5 func(E) F,
+	f6 func(F) G,
+	f7 func(G) H,
+	f8 func(H) I,
+	f9 func(I) J,
+	f10 func(J) K,
+) func(A) K {
+	return func(a A) K {
+		return Pipe10(a, f1, f2, f3, f4, f5, f6, f7, f8, f9, f10)
+	}
+}
+
+// Curry2 turns a function of two arguments into a function returning a
+// function of the second argument.
+func Curry2[A, B, C any](f func(A, B) C) func(A) func(B) C {
+	return func(a A) func(B) C {
+		return func(b B) C { return f(a, b) }
+	}
+}
+
+// Uncurry2 turns a curried function back into a function of two arguments.
+func Uncurry2[A, B, C any](f func(A) func(B) C) func(A, B) C {
+	return func(a A, b B) C { return f(a)(b) }
+}
+
+// Flip swaps the arguments of a function of two arguments.
+func Flip[A, B, C any](f func(A, B) C) func(B, A) C {
+	return func(b B, a A) C { return f(a, b) }
+}
+
+// Constant returns a function ignoring its argument and always returning b.
+func Constant[A, B any](b B) func(A) B {
+	return func(A) B { return b }
+}
+
+// Id is the identity function. It cannot be named Identity, which is the
+// identity monad.
+func Id[T any](x T) T {
+	return x
+}
+
+// Memoize returns a function caching the results of f for each argument. It
+// is safe for concurrent use, though f may be called more than once for the
+// same argument by concurrent calls. f may call the memoized function
+// recursively.
+func Memoize[K comparable, V any](f func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := map[K]V{}
+	return func(k K) V {
+		mu.Lock()
+		v, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return v
+		}
+		v = f(k)
+		mu.Lock()
+		cache[k] = v
+		mu.Unlock()
+		return v
+	}
+}
+
+// ComposeMaybe returns the Kleisli composition of f and g: g is applied to the
+// value of the Maybe returned by f, unless it is nothing.
+func ComposeMaybe[A, B, C any](f func(A) Maybe[B], g func(B) Maybe[C]) func(A) Maybe[C] {
+	return func(a A) Maybe[C] {
+		b := f(a)
+		if b.Nothing() {
+			return None[C]()
+		}
+		return g(b.Value())
+	}
+}
+
+// ComposeResult returns the Kleisli composition of f and g: g is applied to
+// the value of the Result returned by f, unless it is a failure.
+func ComposeResult[A, B, C, E any](f func(A) Result[B, E], g func(B) Result[C, E]) func(A) Result[C, E] {
+	return func(a A) Result[C, E] {
+		b := f(a)
+		if b.Failure() {
+			return Fail[C](b.Error())
+		}
+		return g(b.Value())
+	}
+}
+
+// ComposeList returns the Kleisli composition of f and g: g is applied to
+// every value of the List returned by f, and the results are concatenated.
+func ComposeList[A, B, C any](f func(A) List[B], g func(B) List[C]) func(A) List[C] {
+	return func(a A) List[C] {
+		values := []C{}
+		for _, b := range f(a).Values() {
+			values = append(values, g(b).Values()...)
+		}
+		return NewList(values)
+	}
+}
+
+// ComposeIO returns the Kleisli composition of f and g: the returned IO
+// performs the IO returned by f, then, unless it fails, the IO returned by g.
+func ComposeIO[A, B, C, E any](f func(A) IO[B, E], g func(B) IO[C, E]) func(A) IO[C, E] {
+	return func(a A) IO[C, E] {
+		return NewIO(func() Result[C, E] {
+			b := f(a).Perform()
+			if b.Failure() {
+				return Fail[C](b.Error())
+			}
+			return g(b.Value()).Perform()
+		})
+	}
+}
+
+// ComposeFuture returns the Kleisli composition of f and g: the returned
+// Future awaits the Future returned by f, then, unless it fails, the Future
+// returned by g.
+func ComposeFuture[A, B, C, E any](f func(A) Future[B, E], g func(B) Future[C, E]) func(A) Future[C, E] {
+	return func(a A) Future[C, E] {
+		b := f(a)
+		return NewFuture(func() Result[C, E] {
+			res := b.Await()
+			if res.Failure() {
+				return Fail[C](res.Error())
+			}
+			return g(res.Value()).Await()
+		})
+	}
+}
+
+// ComposeKind returns the Kleisli composition of f and g in the monad
+// described by m.
+func ComposeKind[F, A, B, C any](m MonadInstance[F], f func(A) Kind[F, B], g func(B) Kind[F, C]) func(A) Kind[F, C] {
+	return func(a A) Kind[F, C] {
+		return FlatMapKind(m, f(a), g)
+	}
+}
@@ -0,0 +1,159 @@
+package monad
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeAndFlow(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	inc := func(x int) int { return x + 1 }
+	is.Equal("3", Pipe2(2, inc, strconv.Itoa))
+	is.Equal("3!", Pipe3(2, inc, strconv.Itoa, func(s string) string { return s + "!" }))
+	is.Equal(12, Pipe10(2, inc, inc, inc, inc, inc, inc, inc, inc, inc, inc))
+
+	shout := Flow3(strings.TrimSpace, strings.ToUpper, func(s string) string { return s + "!" })
+	is.Equal("HI!", shout("  hi "))
+	is.Equal(10, Flow9(inc, inc, inc, inc, inc, inc, inc, inc, inc)(1))
+	is.Equal(Pipe5(0, inc, inc, inc, inc, inc), Flow5(inc, inc, inc, inc, inc)(0))
+}
+
+func TestCurryingAndCombinators(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	sub := func(a, b int) int { return a - b }
+	is.Equal(3, Curry2(sub)(5)(2))
+	is.Equal(3, Uncurry2(Curry2(sub))(5, 2))
+	is.Equal(-3, Flip(sub)(5, 2))
+	is.Equal("x", Constant[int]("x")(42))
+	is.Equal(42, Id(42))
+	is.Equal([]int{2, 4}, NewList([]int{1, 2, 3, 4}).Filter(Flow2(Curry2(func(d, x int) int {
+		return x % d
+	})(2), Equals(0))).Values())
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	calls := 0
+	var fib func(int) int
+	fib = Memoize(func(n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	is.Equal(832040, fib(30))
+	is.Equal(31, calls)
+	is.Equal(832040, fib(30))
+	is.Equal(31, calls)
+
+	var wg sync.WaitGroup
+	square := Memoize(func(n int) int { return n * n })
+	squares := make([]int, 10)
+	for i := range squares {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			squares[i] = square(i % 3)
+		}(i)
+	}
+	wg.Wait()
+	is.Equal([]int{0, 1, 4, 0, 1, 4, 0, 1, 4, 0}, squares)
+}
+
+func TestKleisliComposition(t *testing.T) {
+	t.Parallel()
+	errEmpty := errors.New("empty")
+
+	t.Run("Maybe", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		first := func(s string) Maybe[byte] { return Index([]byte(s), 0) }
+		digit := func(b byte) Maybe[int] {
+			n, err := strconv.Atoi(string(b))
+			return FromOk(n, err == nil)
+		}
+		is.Equal(Some(4), ComposeMaybe(first, digit)("42"))
+		is.Equal(None[int](), ComposeMaybe(first, digit)("x"))
+		is.Equal(None[int](), ComposeMaybe(first, digit)(""))
+	})
+
+	t.Run("Result", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		nonEmpty := func(s string) Result[string, error] {
+			if s == "" {
+				return Fail[string](errEmpty)
+			}
+			return Succeed[string, error](s)
+		}
+		parse := func(s string) Result[int, error] { return FromTuple(strconv.Atoi(s)) }
+		is.Equal(Succeed[int, error](7), ComposeResult(nonEmpty, parse)("7"))
+		is.Equal(Fail[int](errEmpty), ComposeResult(nonEmpty, parse)(""))
+		is.True(ComposeResult(nonEmpty, parse)("x").Failure())
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		split := func(s string) List[string] { return NewList(strings.Split(s, ",")) }
+		twice := func(s string) List[int] { return NewList([]int{len(s), len(s)}) }
+		is.Equal([]int{1, 1, 2, 2}, ComposeList(split, twice)("a,bc").Values())
+	})
+
+	t.Run("IO", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var performed []string
+		step := func(name string) func(int) IO[int, error] {
+			return func(x int) IO[int, error] {
+				return NewIO(func() Result[int, error] {
+					performed = append(performed, name)
+					return Succeed[int, error](x + 1)
+				})
+			}
+		}
+		io := ComposeIO(step("f"), step("g"))(1)
+		is.Empty(performed)
+		is.Equal(Succeed[int, error](3), io.Perform())
+		is.Equal([]string{"f", "g"}, performed)
+	})
+
+	t.Run("Future", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		async := func(x int) Future[int, error] {
+			return NewFuture(func() Result[int, error] { return Succeed[int, error](x * 2) })
+		}
+		is.Equal(Succeed[int, error](12), ComposeFuture(async, async)(3).Await())
+	})
+
+	t.Run("Kind", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		m := StateMonad[int]()
+		tick := func(x int) Kind[StateKind[int], int] {
+			return StateToKind(NewState(func(s int) (int, int) { return x + s, s + 1 }))
+		}
+		v, s := KindToState(ComposeKind(m, tick, tick)(10)).Run(1)
+		is.Equal(13, v)
+		is.Equal(3, s)
+	})
+}
@@ -2,6 +2,7 @@ package monad
 
 import (
 	"context"
+	"fmt"
 )
 
 // Continuation represents a monadic interface for continuation-passing style
@@ -38,17 +39,30 @@ func NewContinuation[T any](
 }
 
 // Run executes the encapsulated computation and returns a Result monad.
+//
+// A panic raised by the computation is forwarded to the caller of Run as a
+// *PanicError instead of crashing the program from the computation's
+// goroutine. Use RecoverContinuation to turn such panics into failures.
 func (c continuation[T]) Run(ctx context.Context) Result[T, error] {
 	done := make(chan struct{})
 	var res Result[T, error]
+	var panicked *PanicError
 
 	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				panicked = asPanicError(r)
+			}
+		}()
 		res = c.runFunc(ctx)
-		close(done)
 	}()
 
 	select {
 	case <-done:
+		if panicked != nil {
+			panic(panicked)
+		}
 		return res
 	case <-ctx.Done():
 		return Fail[T, error](ctx.Err())
@@ -77,3 +91,19 @@ func (c continuation[T]) FlatMap(f func(T) Continuation[T]) Continuation[T] {
 		return nextC.Run(ctx)
 	}}
 }
+
+// String describes the Continuation without running it.
+func (c continuation[T]) String() string {
+	return fmt.Sprint(c)
+}
+
+// GoString describes the Continuation without running it.
+func (c continuation[T]) GoString() string {
+	return fmt.Sprintf("%#v", c)
+}
+
+// Format implements fmt.Formatter. Since the Continuation is lazy, only its type is
+// described.
+func (c continuation[T]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "Continuation", typeName[T]())
+}
@@ -0,0 +1,206 @@
+package monad
+
+import "context"
+
+// This file holds the conversions between the monads of the package. Unless
+// stated otherwise, conversions between eager monads (Maybe, Result, Either,
+// Validation, List) are performed immediately, while conversions involving a
+// lazy monad (IO, Future, Continuation, Reader, State) never force evaluation:
+// the source is only run when the resulting value is performed, awaited or run.
+//
+// Identity and Writer have no conversions: they hold an already computed value,
+// available through Value or Run, that the constructors of the other monads
+// take directly.
+
+// MaybeToResult is an alias of ToResult, named after the other conversions of
+// this file: it converts a Maybe into a Result, using err as the failure when
+// the Maybe is nothing.
+func MaybeToResult[T, E any](m Maybe[T], err E) Result[T, E] {
+	return ToResult(m, err)
+}
+
+// ResultToMaybe converts a Result into a Maybe, discarding the error of a
+// failure.
+func ResultToMaybe[T, E any](r Result[T, E]) Maybe[T] {
+	if r.Failure() {
+		return None[T]()
+	}
+	return Some(r.Value())
+}
+
+// MaybeToEither converts a Maybe into an Either: a just value becomes a right
+// value, and nothing becomes a left value holding def.
+func MaybeToEither[T any](m Maybe[T], def T) Either[T] {
+	if m.Nothing() {
+		return NewLVal(def)
+	}
+	return NewRVal(m.Value())
+}
+
+// EitherToMaybe converts an Either into a Maybe, keeping only right values.
+func EitherToMaybe[T any](e Either[T]) Maybe[T] {
+	if e.Left() {
+		return None[T]()
+	}
+	return Some(e.Value())
+}
+
+// MaybeToList converts a Maybe into a List of zero or one element.
+func MaybeToList[T any](m Maybe[T]) List[T] {
+	return NewList(m.ToSlice())
+}
+
+// ListToMaybe returns the first element of a List, nothing if it is empty.
+func ListToMaybe[T any](l List[T]) Maybe[T] {
+	return Index(l.Values(), 0)
+}
+
+// ResultToEither converts a Result into an Either. Since both sides of an
+// Either share the same type, the error of a failure is turned into a left
+// value through onErr, while a success becomes a right value.
+func ResultToEither[T, E any](r Result[T, E], onErr func(E) T) Either[T] {
+	if r.Failure() {
+		return NewLVal(onErr(r.Error()))
+	}
+	return NewRVal(r.Value())
+}
+
+// EitherToResult converts an Either into a Result. A right value becomes a
+// success, while a left value is turned into a failure through onLeft.
+func EitherToResult[T, E any](e Either[T], onLeft func(T) E) Result[T, E] {
+	if e.Left() {
+		return Fail[T, E](onLeft(e.Value()))
+	}
+	return Succeed[T, E](e.Value())
+}
+
+// ResultToValidation converts a Result into a Validation. The error of a
+// failure becomes the single error of an invalid Validation.
+func ResultToValidation[T, E any](r Result[T, E]) Validation[E, T] {
+	if r.Failure() {
+		return NewInvalid[E, T](r.Error())
+	}
+	return NewValid[E, T](r.Value())
+}
+
+// ValidationToResult converts a Validation into a Result whose failure holds
+// all the accumulated errors.
+func ValidationToResult[E, T any](v Validation[E, T]) Result[T, NonEmptyList[E]] {
+	if !v.Valid() {
+		return Fail[T](v.NonEmptyErrors().Value())
+	}
+	return Succeed[T, NonEmptyList[E]](v.Value())
+}
+
+// MaybeToValidation converts a Maybe into a Validation, using err as the single
+// error when the Maybe is nothing.
+func MaybeToValidation[E, T any](m Maybe[T], err E) Validation[E, T] {
+	return ResultToValidation(ToResult(m, err))
+}
+
+// ValidationToMaybe converts a Validation into a Maybe, discarding the errors.
+func ValidationToMaybe[E, T any](v Validation[E, T]) Maybe[T] {
+	if !v.Valid() {
+		return None[T]()
+	}
+	return Some(v.Value())
+}
+
+// ResultToIO lifts an already computed Result into an IO that returns it.
+func ResultToIO[T, E any](r Result[T, E]) IO[T, E] {
+	return NewIO(func() Result[T, E] { return r })
+}
+
+// ResultToFuture lifts an already computed Result into a Future that resolves
+// to it.
+func ResultToFuture[T, E any](r Result[T, E]) Future[T, E] {
+	return NewFuture(func() Result[T, E] { return r })
+}
+
+// ResultToContinuation lifts an already computed Result into a Continuation
+// that produces it, unless the context is done first.
+func ResultToContinuation[T any](r Result[T, error]) Continuation[T] {
+	return NewContinuation(func(_ context.Context) Result[T, error] { return r })
+}
+
+// IOToFuture converts an IO into a Future. The IO is performed on the first
+// call to Await, and its Result is memoized: it is performed at most once no
+// matter how many times the Future is awaited.
+func IOToFuture[T, E any](i IO[T, E]) Future[T, E] {
+	return NewFuture(i.Perform)
+}
+
+// FutureToIO converts a Future into an IO that awaits it. Since the Future
+// memoizes its Result, the underlying action runs at most once, however many
+// times the IO is performed.
+func FutureToIO[T, E any](f Future[T, E]) IO[T, E] {
+	return NewIO(f.Await)
+}
+
+// IOToContinuation converts an IO into a Continuation. The IO is performed
+// every time the Continuation is run; since an IO has no notion of context,
+// cancellation only stops the Continuation from waiting for it.
+func IOToContinuation[T any](i IO[T, error]) Continuation[T] {
+	return NewContinuation(func(_ context.Context) Result[T, error] {
+		return i.Perform()
+	})
+}
+
+// ContinuationToIO converts a Continuation into an IO that runs it with ctx
+// every time it is performed.
+func ContinuationToIO[T any](ctx context.Context, c Continuation[T]) IO[T, error] {
+	return NewIO(func() Result[T, error] {
+		return c.Run(ctx)
+	})
+}
+
+// FutureToContinuation converts a Future into a Continuation that awaits it.
+// Cancelling the context stops the Continuation from waiting, but the Future
+// still completes and memoizes its Result.
+func FutureToContinuation[T any](f Future[T, error]) Continuation[T] {
+	return NewContinuation(func(_ context.Context) Result[T, error] {
+		return f.Await()
+	})
+}
+
+// ContinuationToFuture converts a Continuation into a Future that runs it with
+// ctx on the first call to Await and memoizes its Result, including a
+// cancellation failure.
+func ContinuationToFuture[T any](ctx context.Context, c Continuation[T]) Future[T, error] {
+	return NewFuture(func() Result[T, error] {
+		return c.Run(ctx)
+	})
+}
+
+// ReaderToState converts a Reader into a State reading its environment from the
+// state, which it leaves unchanged.
+func ReaderToState[S, T any](r Reader[S, T]) State[S, T] {
+	return NewState(func(st S) (T, S) {
+		return r.Run(st), st
+	})
+}
+
+// StateToReader converts a State into a Reader running it from the environment
+// as initial state, and discarding the final state.
+func StateToReader[S, T any](s State[S, T]) Reader[S, T] {
+	return NewReader(func(env S) T {
+		v, _ := s.Run(env)
+		return v
+	})
+}
+
+// ReaderToIO converts a Reader into an IO that runs it with env every time it
+// is performed. The IO always succeeds.
+func ReaderToIO[E, T any](r Reader[E, T], env E) IO[T, error] {
+	return NewIO(func() Result[T, error] {
+		return Succeed[T, error](r.Run(env))
+	})
+}
+
+// StateToIO converts a State into an IO that runs it from initial every time it
+// is performed, succeeding with the resulting value and state.
+func StateToIO[S, T any](s State[S, T], initial S) IO[Pair[T, S], error] {
+	return NewIO(func() Result[Pair[T, S], error] {
+		return Succeed[Pair[T, S], error](NewPair(s.Run(initial)))
+	})
+}
@@ -0,0 +1,210 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertEager(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("test")
+
+	t.Run("Maybe and Result", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal(Succeed[int, error](1), MaybeToResult(Some(1), errTest))
+		is.Equal(Fail[int](errTest), MaybeToResult(None[int](), errTest))
+		is.Equal(ToResult(None[int](), errTest), MaybeToResult(None[int](), errTest))
+		is.Equal(Some(1), ResultToMaybe(Succeed[int, error](1)))
+		is.True(ResultToMaybe(Fail[int](errTest)).Nothing())
+	})
+
+	t.Run("Maybe and Either", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal(NewRVal(1), MaybeToEither(Some(1), 0))
+		is.Equal(NewLVal(0), MaybeToEither(None[int](), 0))
+		is.Equal(Some(1), EitherToMaybe(NewRVal(1)))
+		is.True(EitherToMaybe(NewLVal(1)).Nothing())
+	})
+
+	t.Run("Maybe and List", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal([]int{1}, MaybeToList(Some(1)).Values())
+		is.Empty(MaybeToList(None[int]()).Values())
+		is.Equal(Some(1), ListToMaybe(NewList([]int{1, 2})))
+		is.True(ListToMaybe(NewList([]int{})).Nothing())
+	})
+
+	t.Run("Result and Either", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		onErr := func(err error) string { return err.Error() }
+		is.Equal(NewRVal("ok"), ResultToEither(Succeed[string, error]("ok"), onErr))
+		is.Equal(NewLVal("test"), ResultToEither(Fail[string](errTest), onErr))
+
+		onLeft := func(s string) error { return errors.New(s) }
+		is.Equal(Succeed[string, error]("ok"), EitherToResult(NewRVal("ok"), onLeft))
+		is.EqualError(EitherToResult(NewLVal("ko"), onLeft).Error(), "ko")
+	})
+
+	t.Run("Result and Validation", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal(NewValid[error](1), ResultToValidation(Succeed[int, error](1)))
+		is.Equal(NewNonEmptyList(errTest), ResultToValidation(Fail[int](errTest)).NonEmptyErrors().Value())
+
+		is.Equal(Succeed[int, NonEmptyList[string]](1), ValidationToResult(NewValid[string](1)))
+		invalid := NewInvalid[string, int]("a", "b")
+		is.Equal(Fail[int](NewNonEmptyList("a", "b")), ValidationToResult(invalid))
+	})
+
+	t.Run("Maybe and Validation", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.True(MaybeToValidation(Some(1), "missing").Valid())
+		is.Equal(NewNonEmptyList("missing"), MaybeToValidation(None[int](), "missing").NonEmptyErrors().Value())
+		is.Equal(Some(1), ValidationToMaybe(NewValid[string](1)))
+		is.True(ValidationToMaybe(NewInvalid[string, int]("a")).Nothing())
+	})
+}
+
+func TestConvertLazy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("IO to Future is lazy and runs once", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		f := IOToFuture(NewIO(func() Result[int, error] {
+			calls++
+			return Succeed[int, error](calls)
+		}))
+		is.Equal(0, calls)
+		is.Equal(1, f.Await().Value())
+		is.Equal(1, f.Await().Value())
+		is.Equal(1, calls)
+	})
+
+	t.Run("Future to IO awaits the memoized Future", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		i := FutureToIO(NewFuture(func() Result[int, error] {
+			calls++
+			return Succeed[int, error](42)
+		}))
+		is.Equal(0, calls)
+		is.Equal(42, i.Perform().Value())
+		is.Equal(42, i.Perform().Value())
+		is.Equal(1, calls)
+	})
+
+	t.Run("IO and Continuation", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		c := IOToContinuation(NewIO(func() Result[int, error] {
+			calls++
+			return Succeed[int, error](calls)
+		}))
+		is.Equal(0, calls)
+		is.Equal(1, c.Run(context.Background()).Value())
+		is.Equal(2, c.Run(context.Background()).Value())
+
+		i := ContinuationToIO(context.Background(), c)
+		is.Equal(3, i.Perform().Value())
+	})
+
+	t.Run("Future and Continuation", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		c := NewContinuation(func(_ context.Context) Result[int, error] {
+			calls++
+			return Succeed[int, error](calls)
+		})
+		f := ContinuationToFuture(context.Background(), c)
+		is.Equal(0, calls)
+		is.Equal(1, f.Await().Value())
+		is.Equal(1, f.Await().Value())
+
+		is.Equal(1, FutureToContinuation(f).Run(context.Background()).Value())
+	})
+
+	t.Run("Continuation to Future memoizes cancellation", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c := NewContinuation(func(ctx context.Context) Result[int, error] {
+			<-ctx.Done()
+			return Fail[int](ctx.Err())
+		})
+		f := ContinuationToFuture(ctx, c)
+		is.ErrorIs(f.Await().Error(), context.Canceled)
+	})
+
+	t.Run("Results lift into lazy monads", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		r := Succeed[int, error](1)
+		is.Equal(r, ResultToIO(r).Perform())
+		is.Equal(r, ResultToFuture(r).Await())
+		is.Equal(r, ResultToContinuation(r).Run(context.Background()))
+	})
+
+	t.Run("Reader and State", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		double := NewReader(func(env int) int {
+			calls++
+			return env * 2
+		})
+		s := ReaderToState(double)
+		is.Equal(0, calls)
+		v, st := s.Run(21)
+		is.Equal(42, v)
+		is.Equal(21, st)
+
+		counter := NewState(func(st int) (int, int) { return st * 10, st + 1 })
+		is.Equal(20, StateToReader(counter).Run(2))
+	})
+
+	t.Run("Reader and State to IO", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		i := ReaderToIO(NewReader(func(env string) int {
+			calls++
+			return len(env)
+		}), "abc")
+		is.Equal(0, calls)
+		is.Equal(Succeed[int, error](3), i.Perform())
+		is.Equal(Succeed[int, error](3), i.Perform())
+		is.Equal(2, calls)
+
+		counter := NewState(func(st int) (int, int) { return st * 10, st + 1 })
+		is.Equal(Succeed[Pair[int, int], error](NewPair(20, 3)), StateToIO(counter, 2).Perform())
+	})
+}
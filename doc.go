@@ -13,6 +13,9 @@
 // - Right Identity: m >>= return  is equivalent to  m
 // - Associativity:  (m >>= f) >>= g  is equivalent to  m >>= (\x -> f x >>= g)
 //
+// These laws, along with the functor laws, are checked with randomized inputs
+// by the monadtest subpackage, which can also check user-defined monads.
+//
 // The following monads are implemented:
 //   - Maybe: Represents optional values and handles the absence of a value.
 //   - Either: Extends Maybe by encapsulating an error or alternate reason for
@@ -21,6 +24,8 @@
 //     successful result or an error.
 //   - Identity: The simplest monad, acting as a container for a single value.
 //   - List: Represents a collection of values in a monadic context.
+//   - NonEmptyList: A List holding at least one value, used for the errors of
+//     an invalid Validation.
 //   - Reader: Encapsulates a shared environment required by various
 //     computations.
 //   - Writer: Captures additional output during the computation, useful for
@@ -29,11 +34,17 @@
 //     structure.
 //   - Validation: To accumulate all errors rather than failing fast,
 //     useful in form validation.
+//   - Ior: Holds a left value, a right value or both, such as a result along
+//     with warnings, accumulating left values through a Semigroup.
 //   - Continuation: Suited for asynchronous or nested computations.
 //   - IO Monad: To encapsulate effectful computations in a functional style.
 //   - Future: To encapsulate future asynchronous computations.
 //   - Free Monad: An advanced construct to build interpreters for embedded
 //     DSLs.
+//   - Trampoline: Runs recursive computations in constant stack space. State,
+//     IO, Reader and Free use it so that long chains of FlatMap are safe.
+//   - Eval: Controls when a pure value is computed, eagerly with Now, once
+//     with Later, or on every access with Always.
 //
 // Planned monads:
 //   - RWS (Reader-Writer-State) Monad: An amalgam of the Reader, Writer, and
@@ -70,6 +81,10 @@
 // Each monad is endowed with functional methods like `FlatMap` and `Map` to
 // facilitate composability and side-effect management.
 //
+// Since Go lacks higher-kinded types, every monad also has a brand, such as
+// MaybeKind, and a MonadInstance dictionary, such as MaybeMonad. Functions
+// written over a Kind, like Traverse, Sequence or FoldM, work with any monad.
+//
 // Usage:
 // Consult the associated documentation for each individual monad to explore
 // example usage and further details.
@@ -1,5 +1,10 @@
 package monad
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Either monad represents two equivalent values, left or right.
 // Right is by convention the "default value".
 type Either[T any] interface {
@@ -53,6 +58,27 @@ func (l left[T]) Or(f func(T) Either[T]) Either[T] {
 	return f(l.val)
 }
 
+// String formats the Either as Left(value).
+func (l left[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// GoString formats the Either as a call to NewLVal.
+func (l left[T]) GoString() string {
+	return fmt.Sprintf("%#v", l)
+}
+
+// Format implements fmt.Formatter, applying the verb to the underlying value.
+func (l left[T]) Format(f fmt.State, verb rune) {
+	formatCase(f, verb, "Left", generic("NewLVal", typeName[T]()), l.val)
+}
+
+// LogValue implements slog.LogValuer, logging the Either as a group holding
+// side=left and the value.
+func (l left[T]) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("side", "left"), slog.Any("value", l.val))
+}
+
 // right represents a right value.
 type right[T any] struct {
 	val T
@@ -94,3 +120,24 @@ func (r right[T]) FlatMap(f func(T) Either[T]) Either[T] {
 func (r right[T]) Or(f func(T) Either[T]) Either[T] {
 	return r
 }
+
+// String formats the Either as Right(value).
+func (r right[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// GoString formats the Either as a call to NewRVal.
+func (r right[T]) GoString() string {
+	return fmt.Sprintf("%#v", r)
+}
+
+// Format implements fmt.Formatter, applying the verb to the underlying value.
+func (r right[T]) Format(f fmt.State, verb rune) {
+	formatCase(f, verb, "Right", generic("NewRVal", typeName[T]()), r.val)
+}
+
+// LogValue implements slog.LogValuer, logging the Either as a group holding
+// side=right and the value.
+func (r right[T]) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("side", "right"), slog.Any("value", r.val))
+}
@@ -0,0 +1,125 @@
+package monad
+
+import "fmt"
+
+// Eval represents a value of type T with a given evaluation strategy: computed
+// eagerly with Now, lazily and once with Later, or lazily on every access with
+// Always. It is a lightweight alternative to Future for expensive pure
+// computations that do not need to run concurrently.
+//
+// Map and FlatMap are lazy and stack-safe: chains of any length are evaluated
+// in constant stack space when Value is called.
+//
+// Evals created by Later or Memoize cache their value without synchronization,
+// and are therefore not safe for concurrent use.
+type Eval[T any] interface {
+	// Value evaluates the Eval and returns its value.
+	Value() T
+
+	// Map applies a function to the value of the Eval, yielding a new Eval.
+	Map(func(T) any) Eval[any]
+
+	// FlatMap chains an Eval computed from the value of this one.
+	FlatMap(func(T) Eval[T]) Eval[T]
+
+	// Memoize returns an Eval computing its value at most once, the first time
+	// it is needed.
+	Memoize() Eval[T]
+}
+
+// eval is the concrete implementation of the Eval interface. It describes its
+// computation as a Trampoline.
+type eval[T any] struct {
+	step func() Trampoline[T]
+}
+
+// memo holds the value of a memoized Eval once it is computed.
+type memo[T any] struct {
+	value T
+	done  bool
+}
+
+// Now creates an Eval holding an already computed value.
+func Now[T any](value T) Eval[T] {
+	return eval[T]{step: func() Trampoline[T] { return Done(value) }}
+}
+
+// Later creates an Eval computing its value with f the first time it is
+// needed, and caching it afterwards.
+func Later[T any](f func() T) Eval[T] {
+	return Always(f).Memoize()
+}
+
+// Always creates an Eval computing its value with f every time it is needed.
+func Always[T any](f func() T) Eval[T] {
+	return eval[T]{step: func() Trampoline[T] { return Done(f()) }}
+}
+
+// Defer creates an Eval whose computation is the one of the Eval returned by
+// f, which is only called when the value is needed. It allows defining
+// recursive Evals without evaluating them.
+func Defer[T any](f func() Eval[T]) Eval[T] {
+	return eval[T]{step: func() Trampoline[T] { return evalStep(f()) }}
+}
+
+// Value runs the computation of the Eval.
+func (e eval[T]) Value() T {
+	return e.step().Run()
+}
+
+// Map applies f to the value of the Eval.
+func (e eval[T]) Map(f func(T) any) Eval[any] {
+	return eval[any]{step: func() Trampoline[any] {
+		return evalStep[T](e).Map(f)
+	}}
+}
+
+// FlatMap chains f after the Eval.
+func (e eval[T]) FlatMap(f func(T) Eval[T]) Eval[T] {
+	return eval[T]{step: func() Trampoline[T] {
+		return evalStep[T](e).FlatMap(func(x T) Trampoline[T] {
+			return evalStep(f(x))
+		})
+	}}
+}
+
+// Memoize caches the value of the Eval the first time it is computed.
+func (e eval[T]) Memoize() Eval[T] {
+	m := &memo[T]{}
+	return eval[T]{step: func() Trampoline[T] {
+		if m.done {
+			return Done(m.value)
+		}
+		return evalStep[T](e).FlatMap(func(x T) Trampoline[T] {
+			m.value, m.done = x, true
+			return Done(x)
+		})
+	}}
+}
+
+// evalStep returns the computation of e as a Trampoline, deferring it so that
+// it runs within the loop of the Trampoline.
+func evalStep[T any](e Eval[T]) Trampoline[T] {
+	return More(func() Trampoline[T] {
+		if impl, ok := e.(eval[T]); ok {
+			return impl.step()
+		}
+		return Done(e.Value())
+	})
+}
+
+// String describes the Eval without evaluating it.
+func (e eval[T]) String() string {
+	return fmt.Sprint(e)
+}
+
+// GoString describes the Eval without evaluating it.
+func (e eval[T]) GoString() string {
+	return fmt.Sprintf("%#v", e)
+}
+
+// Format implements fmt.Formatter. Since the Eval may be lazy, only its type is
+// described.
+func (e eval[T]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "Eval", typeName[T]())
+}
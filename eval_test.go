@@ -0,0 +1,130 @@
+package monad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvalStrategies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Now", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal(42, Now(42).Value())
+	})
+
+	t.Run("Later computes once, when needed", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		e := Later(func() int {
+			calls++
+			return 42
+		})
+		is.Equal(0, calls)
+		is.Equal(42, e.Value())
+		is.Equal(42, e.Value())
+		is.Equal(1, calls)
+	})
+
+	t.Run("Always computes every time", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		e := Always(func() int {
+			calls++
+			return calls
+		})
+		is.Equal(0, calls)
+		is.Equal(1, e.Value())
+		is.Equal(2, e.Value())
+	})
+
+	t.Run("Memoize", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		e := Always(func() int {
+			calls++
+			return calls
+		}).FlatMap(func(x int) Eval[int] { return Now(x * 10) }).Memoize()
+		is.Equal(10, e.Value())
+		is.Equal(10, e.Value())
+		is.Equal(1, calls)
+	})
+
+	t.Run("Defer", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		called := false
+		e := Defer(func() Eval[int] {
+			called = true
+			return Now(1)
+		})
+		is.False(called)
+		is.Equal(1, e.Value())
+		is.True(called)
+	})
+}
+
+func TestEvalMapAndFlatMap(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	calls := 0
+	e := Later(func() int {
+		calls++
+		return 20
+	})
+	mapped := e.Map(func(x int) any { return fmt.Sprint(x + 1) })
+	chained := e.FlatMap(func(x int) Eval[int] { return Now(x * 2) })
+	is.Equal(0, calls, "Map and FlatMap are lazy")
+	is.Equal("21", mapped.Value())
+	is.Equal(40, chained.Value())
+	is.Equal(1, calls, "the Later value is shared by the Evals derived from it")
+	is.Equal("Eval[int]", fmt.Sprint(e))
+}
+
+func TestEvalIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	e := Now(0)
+	for i := 0; i < steps; i++ {
+		e = e.FlatMap(func(x int) Eval[int] { return Now(x + 1) })
+	}
+	is.Equal(steps, e.Value())
+
+	var even, odd func(int) Eval[bool]
+	even = func(n int) Eval[bool] {
+		if n == 0 {
+			return Now(true)
+		}
+		return Defer(func() Eval[bool] { return odd(n - 1) })
+	}
+	odd = func(n int) Eval[bool] {
+		if n == 0 {
+			return Now(false)
+		}
+		return Defer(func() Eval[bool] { return even(n - 1) })
+	}
+	is.True(even(steps).Value())
+}
+
+func TestEvalKind(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	total := FoldM(EvalMonad(), NewList([]int{1, 2, 3}), 0, func(acc, x int) Kind[EvalKind, int] {
+		return EvalToKind(Later(func() int { return acc + x }))
+	})
+	is.Equal(6, KindToEval(total).Value())
+}
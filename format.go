@@ -0,0 +1,60 @@
+package monad
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// labeled is a value printed with its label when formatted with %+v.
+type labeled struct {
+	label string
+	value any
+}
+
+// formatCase writes a monadic case as name(args...), formatting every argument
+// with the directive the case itself is formatted with, so that flags such as
+// %+v propagate to nested values. When formatted with %#v, goName is written
+// instead of name and labels are dropped, yielding a Go-syntax representation.
+func formatCase(f fmt.State, verb rune, name, goName string, args ...any) {
+	goSyntax := verb == 'v' && f.Flag('#')
+	if goSyntax {
+		name = goName
+	}
+	directive := fmt.FormatString(f, verb)
+
+	_, _ = fmt.Fprint(f, name+"(")
+	for i, arg := range args {
+		if i > 0 {
+			_, _ = fmt.Fprint(f, ", ")
+		}
+		if l, ok := arg.(labeled); ok {
+			if f.Flag('+') && !goSyntax {
+				_, _ = fmt.Fprint(f, l.label+": ")
+			}
+			arg = l.value
+		}
+		_, _ = fmt.Fprintf(f, directive, arg)
+	}
+	_, _ = fmt.Fprint(f, ")")
+}
+
+// formatLazy writes the description of a lazy monad, which is its name followed
+// by its type arguments, prefixed by the package name when formatted with %#v.
+func formatLazy(f fmt.State, verb rune, name string, typeArgs ...string) {
+	if verb == 'v' && f.Flag('#') {
+		name = "monad." + name
+	}
+	_, _ = fmt.Fprint(f, name+"["+strings.Join(typeArgs, ", ")+"]")
+}
+
+// typeName returns the name of the type T, even when T is an interface type.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// generic returns the Go-syntax name of a generic function instantiated with
+// the given type arguments.
+func generic(name string, typeArgs ...string) string {
+	return "monad." + name + "[" + strings.Join(typeArgs, ", ") + "]"
+}
@@ -0,0 +1,129 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatEager(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("boom")
+	cases := []struct {
+		name   string
+		format string
+		value  any
+		want   string
+	}{
+		{"Some", "%v", Some(3), "Some(3)"},
+		{"Some with verb", "%03d", Some(3), "Some(003)"},
+		{"Some Go syntax", "%#v", Some(3), "monad.Some[int](3)"},
+		{"None", "%v", None[int](), "None"},
+		{"None Go syntax", "%#v", None[int](), "monad.None[int]()"},
+		{"Ok", "%v", Succeed[int, error](3), "Ok(3)"},
+		{"Err", "%v", Fail[int](errTest), "Err(boom)"},
+		{"Err Go syntax", "%#v", Fail[int, string]("boom"), `monad.Fail[int, string]("boom")`},
+		{"Left", "%v", NewLVal("a"), "Left(a)"},
+		{"Right quoted", "%q", NewRVal("a"), `Right("a")`},
+		{"Valid", "%v", NewValid[string](1), "Valid(1)"},
+		{"Invalid", "%v", NewInvalid[string, int]("a", "b"), "Invalid(a, b)"},
+		{
+			"Invalid Go syntax", "%#v", NewInvalid[string, int]("a"),
+			`monad.NewInvalid[string, int]("a")`,
+		},
+		{"List", "%v", NewList([]int{1, 2, 3}), "List(1, 2, 3)"},
+		{"Empty list", "%v", NewList([]int{}), "List()"},
+		{"List Go syntax", "%#v", NewList([]int{1, 2}), "monad.NewList[int]([]int{1, 2})"},
+		{"Identity", "%v", NewIdentity(3), "Identity(3)"},
+		{"Writer", "%v", NewWriter(3, "log"), "Writer(3, log)"},
+		{"Writer detailed", "%+v", NewWriter(3, "log"), "Writer(value: 3, output: log)"},
+		{"Writer Go syntax", "%#v", NewWriter(3, "log"), `monad.NewWriter[string, int](3, "log")`},
+		{
+			"Writer with Go syntax", "%#v", NewWriterWith(3, "log", MonoidString()),
+			`monad.NewWriterWith[string, int](3, "log")`,
+		},
+		{"Ior left", "%v", NewIorLeft[string, int](MonoidString(), "w"), "Left(w)"},
+		{"Ior right", "%v", NewIorRight[string](MonoidString(), 1), "Right(1)"},
+		{"Ior both detailed", "%+v", NewIorBoth(MonoidString(), "w", 1), "Both(left: w, right: 1)"},
+		{
+			"Ior both Go syntax", "%#v", NewIorBoth(MonoidString(), "w", 1),
+			`monad.NewIorBoth[string, int]("w", 1)`,
+		},
+		{"Nested", "%v", Some(Succeed[int, error](1)), "Some(Ok(1))"},
+		{"Nested detailed", "%+v", Some(NewWriter(1, "w")), "Some(Writer(value: 1, output: w))"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, fmt.Sprintf(tc.format, tc.value))
+		})
+	}
+}
+
+func TestFormatStringers(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal("Some(3)", Some(3).(fmt.Stringer).String())
+	is.Equal("monad.Some[int](3)", Some(3).(fmt.GoStringer).GoString())
+	is.Equal("None", None[int]().(fmt.Stringer).String())
+	is.Equal("Ok(3)", Succeed[int, error](3).(fmt.Stringer).String())
+	is.Equal("Right(3)", NewRVal(3).(fmt.Stringer).String())
+}
+
+func TestFormatLazy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Lazy monads are not evaluated", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		called := false
+		io := NewIO(func() Result[int, error] { called = true; return Succeed[int, error](1) })
+		is.Equal("IO[int, error]", fmt.Sprint(io))
+		is.Equal("monad.IO[int, error]", fmt.Sprintf("%#v", io))
+
+		r := NewReader(func(env string) int { called = true; return len(env) })
+		is.Equal("Reader[string, int]", fmt.Sprint(r))
+
+		c := NewContinuation(func(ctx context.Context) Result[int, error] {
+			called = true
+			return Succeed[int, error](1)
+		})
+		is.Equal("Continuation[int]", fmt.Sprint(c))
+
+		s := NewState(func(s int) (string, int) { called = true; return "", s })
+		is.Equal("State[int, string]", fmt.Sprint(s))
+
+		is.False(called)
+	})
+
+	t.Run("Future shows its result once computed", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		f := NewFuture(func() Result[int, error] { return Succeed[int, error](1) })
+		is.Equal("Future[int, error](pending)", fmt.Sprint(f))
+		f.Await()
+		is.Equal("Future[int, error](Ok(1))", fmt.Sprint(f))
+	})
+}
+
+func TestFormatErrors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	res := TryCatch(func() int { panic("boom") })
+	is.Equal("Err(panic: boom)", fmt.Sprint(res))
+	is.Contains(fmt.Sprintf("%+v", res), "TestFormatErrors")
+
+	named := Named(FailHere[int](errors.New("leaf")), "step")
+	is.Equal("Err(step: leaf)", fmt.Sprint(named))
+	is.Contains(fmt.Sprintf("%+v", named), "\tat step")
+}
@@ -33,9 +33,40 @@ func NewFreeOp[F any, A any](functor Functor[F], cont func(F) Free[F, A]) Free[F
 	return freeOp[F, A]{functor: functor, cont: cont}
 }
 
-// FlatMap unwraps the pure value and applies the given function to it, yielding another Free Monad.
+// freeBind represents a Free operation followed by a continuation. Chaining
+// lazily rather than nesting continuations lets RunFree interpret long chains
+// of FlatMap in constant stack space.
+type freeBind[F any, A any] struct {
+	sub  Free[F, A]
+	cont func(A) Free[F, A]
+}
+
+// freeMap represents a Free operation whose result is transformed by fn.
+type freeMap[F any, A any] struct {
+	sub Free[F, A]
+	fn  func(A) any
+}
+
+// freeStepper is implemented by the Free operations of this package, which
+// can be interpreted as a Trampoline.
+type freeStepper[F any, A any] interface {
+	trampoline(interpreter func(F) A) Trampoline[A]
+}
+
+// freeStep returns the interpretation of m as a Trampoline, deferring it so
+// that it runs within the loop of the Trampoline.
+func freeStep[F any, A any](m Free[F, A], interpreter func(F) A) Trampoline[A] {
+	return More(func() Trampoline[A] {
+		if s, ok := m.(freeStepper[F, A]); ok {
+			return s.trampoline(interpreter)
+		}
+		return Done(m.RunFree(interpreter))
+	})
+}
+
+// FlatMap chains the given function after the pure value, yielding another Free Monad.
 func (p pure[F, A]) FlatMap(fn func(A) Free[F, A]) Free[F, A] {
-	return fn(p.value)
+	return freeBind[F, A]{sub: p, cont: fn}
 }
 
 // Map unwraps the pure value and applies the given function to it, yielding another Free Monad.
@@ -48,24 +79,66 @@ func (p pure[F, A]) RunFree(_ func(F) A) A {
 	return p.value
 }
 
+func (p pure[F, A]) trampoline(_ func(F) A) Trampoline[A] {
+	return Done(p.value)
+}
+
 // FlatMap composes the functor with another Free Monad, effectively chaining operations.
 func (op freeOp[F, A]) FlatMap(fn func(A) Free[F, A]) Free[F, A] {
-	newCont := func(f F) Free[F, A] {
-		return op.cont(f).FlatMap(fn)
-	}
-	return NewFreeOp(op.functor, newCont)
+	return freeBind[F, A]{sub: op, cont: fn}
 }
 
 // Map transforms the value inside the Free Monad using a given function, yielding a new Free Monad.
 func (op freeOp[F, A]) Map(fn func(A) any) Free[F, any] {
-	newCont := func(f F) Free[F, any] {
-		return op.cont(f).Map(fn)
-	}
-	return NewFreeOp(op.functor, newCont)
+	return freeMap[F, A]{sub: op, fn: fn}
 }
 
 // RunFree interprets the Free Monad using the provided interpreter function to yield a result.
 func (op freeOp[F, A]) RunFree(interpreter func(F) A) A {
-	next := op.cont(op.functor.Extract())
-	return next.RunFree(interpreter)
+	return freeStep[F, A](op, interpreter).Run()
+}
+
+func (op freeOp[F, A]) trampoline(interpreter func(F) A) Trampoline[A] {
+	return freeStep(op.cont(op.functor.Extract()), interpreter)
+}
+
+// FlatMap chains another continuation after this one.
+func (b freeBind[F, A]) FlatMap(fn func(A) Free[F, A]) Free[F, A] {
+	return freeBind[F, A]{sub: b, cont: fn}
+}
+
+// Map transforms the result of the chained operations.
+func (b freeBind[F, A]) Map(fn func(A) any) Free[F, any] {
+	return freeMap[F, A]{sub: b, fn: fn}
+}
+
+// RunFree interprets the operation, then the Free Monad its continuation returns.
+func (b freeBind[F, A]) RunFree(interpreter func(F) A) A {
+	return freeStep[F, A](b, interpreter).Run()
+}
+
+func (b freeBind[F, A]) trampoline(interpreter func(F) A) Trampoline[A] {
+	return freeStep(b.sub, interpreter).FlatMap(func(a A) Trampoline[A] {
+		return freeStep(b.cont(a), interpreter)
+	})
+}
+
+// FlatMap chains a continuation after the transformed operation.
+func (m freeMap[F, A]) FlatMap(fn func(any) Free[F, any]) Free[F, any] {
+	return freeBind[F, any]{sub: m, cont: fn}
+}
+
+// Map transforms the result of the transformed operation again.
+func (m freeMap[F, A]) Map(fn func(any) any) Free[F, any] {
+	return freeMap[F, any]{sub: m, fn: fn}
+}
+
+// RunFree interprets the operation and transforms its result.
+func (m freeMap[F, A]) RunFree(interpreter func(F) any) any {
+	return freeStep[F, any](m, interpreter).Run()
+}
+
+func (m freeMap[F, A]) trampoline(interpreter func(F) any) Trampoline[any] {
+	subInterpreter := func(f F) A { return cast[A](interpreter(f)) }
+	return freeStep(m.sub, subInterpreter).Map(m.fn)
 }
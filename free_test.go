@@ -119,3 +119,22 @@ func TestFreeMonad(t *testing.T) {
 		})
 	})
 }
+
+func TestFreeKind(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	xs := make([]int, 10_000)
+	for i := range xs {
+		xs[i] = i + 1
+	}
+	total := FoldM(FreeMonad[TestFunctor](), NewList(xs), 0, func(acc, x int) Kind[FreeKind[TestFunctor], int] {
+		return FreeToKind(NewFreeOp[TestFunctor, int](TestFunctor{Value: x}, func(f TestFunctor) Free[TestFunctor, int] {
+			return NewPure[TestFunctor](acc + f.Value)
+		}))
+	})
+	is.Equal(50_005_000, KindToFree(total).RunFree(interpreterInt))
+
+	doubled := KindToFree(MapKind(FreeMonad[TestFunctor](), total, func(x int) int { return x * 2 }))
+	is.Equal(100_010_000, doubled.RunFree(interpreterInt))
+}
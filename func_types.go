@@ -1,5 +1,10 @@
 package monad
 
+import (
+	"cmp"
+	"regexp"
+)
+
 // Predicate represents functions that perform a test on a value
 type Predicate[T any] func(T) bool
 
@@ -8,3 +13,131 @@ type Failable[T any] func(T) (T, error)
 
 // Nilable represents a function that takes a value of type T and returns a nullable of type T
 type Nilable[T any] func(T) *T
+
+// And returns a predicate holding when both p and q hold. q is not tested
+// when p does not hold.
+func And[T any](p, q Predicate[T]) Predicate[T] {
+	return func(x T) bool { return p(x) && q(x) }
+}
+
+// Or returns a predicate holding when p or q holds. q is not tested when p
+// holds.
+func Or[T any](p, q Predicate[T]) Predicate[T] {
+	return func(x T) bool { return p(x) || q(x) }
+}
+
+// Not returns a predicate holding when p does not.
+func Not[T any](p Predicate[T]) Predicate[T] {
+	return func(x T) bool { return !p(x) }
+}
+
+// All returns a predicate holding when every predicate of ps holds, which is
+// always the case when ps is empty.
+func All[T any](ps ...Predicate[T]) Predicate[T] {
+	return func(x T) bool {
+		for _, p := range ps {
+			if !p(x) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Any returns a predicate holding when at least one predicate of ps holds,
+// which is never the case when ps is empty.
+func Any[T any](ps ...Predicate[T]) Predicate[T] {
+	return func(x T) bool {
+		for _, p := range ps {
+			if p(x) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NoneOf returns a predicate holding when no predicate of ps holds. It is
+// named after None, which creates an empty Maybe.
+func NoneOf[T any](ps ...Predicate[T]) Predicate[T] {
+	return Not(Any(ps...))
+}
+
+// ContramapPredicate returns a predicate testing p on the values mapped by f,
+// such as a predicate on users testing their name.
+func ContramapPredicate[A, B any](p Predicate[B], f func(A) B) Predicate[A] {
+	return func(x A) bool { return p(f(x)) }
+}
+
+// Equals returns a predicate holding for values equal to want.
+func Equals[T comparable](want T) Predicate[T] {
+	return func(x T) bool { return x == want }
+}
+
+// InRange returns a predicate holding for values between lo and hi, both
+// included.
+func InRange[T cmp.Ordered](lo, hi T) Predicate[T] {
+	return func(x T) bool { return lo <= x && x <= hi }
+}
+
+// MatchesRegexp returns a predicate holding for strings containing a match of
+// re.
+func MatchesRegexp(re *regexp.Regexp) Predicate[string] {
+	return re.MatchString
+}
+
+// NonEmpty returns a predicate holding for non-empty strings.
+func NonEmpty[T ~string]() Predicate[T] {
+	return func(x T) bool { return x != "" }
+}
+
+// Then returns the Kleisli composition of f and g: g is applied to the value
+// returned by f, unless f fails.
+func Then[T any](f, g Failable[T]) Failable[T] {
+	return func(x T) (T, error) {
+		y, err := f(x)
+		if err != nil {
+			return y, err
+		}
+		return g(y)
+	}
+}
+
+// Recover returns a Failable calling handler with the input and the error of f
+// when f fails, giving it a chance to recover.
+func Recover[T any](f Failable[T], handler func(T, error) (T, error)) Failable[T] {
+	return func(x T) (T, error) {
+		y, err := f(x)
+		if err != nil {
+			return handler(x, err)
+		}
+		return y, nil
+	}
+}
+
+// Retry returns a Failable calling f until it succeeds, up to attempts times.
+// The last error is returned when every attempt fails. f is called once when
+// attempts is lower than one.
+func Retry[T any](f Failable[T], attempts int) Failable[T] {
+	return func(x T) (T, error) {
+		y, err := f(x)
+		for i := 1; i < attempts && err != nil; i++ {
+			y, err = f(x)
+		}
+		return y, err
+	}
+}
+
+// ToResultFunc adapts f to return a Result.
+func ToResultFunc[T any](f Failable[T]) func(T) Result[T, error] {
+	return func(x T) Result[T, error] {
+		return FromTuple(f(x))
+	}
+}
+
+// ToMaybeFunc adapts f to return a Maybe, which is nothing when f returns nil.
+func ToMaybeFunc[T any](f Nilable[T]) func(T) Maybe[T] {
+	return func(x T) Maybe[T] {
+		return Nullable(f(x))
+	}
+}
@@ -0,0 +1,159 @@
+package monad
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicateCombinators(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	even := Predicate[int](func(x int) bool { return x%2 == 0 })
+	positive := Predicate[int](func(x int) bool { return x > 0 })
+
+	is.True(And(even, positive)(2))
+	is.False(And(even, positive)(-2))
+	is.True(Or(even, positive)(-2))
+	is.False(Or(even, positive)(-1))
+	is.True(Not(even)(1))
+
+	is.True(All(even, positive, InRange(1, 10))(4))
+	is.False(All(even, positive, InRange(1, 10))(12))
+	is.True(All[int]()(1))
+	is.True(Any(even, Equals(3))(3))
+	is.False(Any[int]()(1))
+	is.True(NoneOf(even, positive)(-1))
+	is.False(NoneOf(even, positive)(1))
+
+	long := ContramapPredicate(InRange(3, 100), func(s string) int { return len(s) })
+	is.True(long("abc"))
+	is.False(long("ab"))
+}
+
+func TestPredicateShortCircuits(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	called := false
+	spy := Predicate[int](func(int) bool {
+		called = true
+		return true
+	})
+	is.False(And(Equals(1), spy)(2))
+	is.True(Or(Equals(2), spy)(2))
+	is.False(called)
+}
+
+func TestPredicateConstructorsWithFilters(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal(Some("go"), Some("go").Filter(NonEmpty[string]()))
+	is.Equal(None[string](), Some("").Filter(NonEmpty[string]()))
+	is.Equal(None[int](), Some(11).Filter(InRange(1, 10)))
+
+	words := NewList([]string{"monad", "", "Maybe", "list"})
+	capitalized := MatchesRegexp(regexp.MustCompile(`^[A-Z]`))
+	is.Equal([]string{"Maybe"}, words.Filter(capitalized).Values())
+	is.Equal([]string{"monad", "list"}, words.Filter(And(NonEmpty[string](), Not(capitalized))).Values())
+	is.Equal([]string{}, words.Filter(Equals("absent")).Values())
+}
+
+func TestFailableCombinators(t *testing.T) {
+	t.Parallel()
+	errNegative := errors.New("negative")
+
+	half := Failable[int](func(x int) (int, error) {
+		if x%2 != 0 {
+			return 0, errors.New("odd")
+		}
+		return x / 2, nil
+	})
+	checkPositive := Failable[int](func(x int) (int, error) {
+		if x < 0 {
+			return 0, errNegative
+		}
+		return x, nil
+	})
+
+	t.Run("Then", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		quarter := Then(half, half)
+		x, err := quarter(12)
+		is.NoError(err)
+		is.Equal(3, x)
+		_, err = quarter(6)
+		is.EqualError(err, "odd")
+		_, err = Then(checkPositive, half)(-1)
+		is.ErrorIs(err, errNegative)
+	})
+
+	t.Run("Recover", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		abs := Recover(checkPositive, func(x int, err error) (int, error) {
+			is.ErrorIs(err, errNegative)
+			return -x, nil
+		})
+		x, err := abs(-3)
+		is.NoError(err)
+		is.Equal(3, x)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		flaky := Failable[string](func(s string) (string, error) {
+			calls++
+			if calls < 3 {
+				return "", errors.New("unavailable")
+			}
+			return strings.ToUpper(s), nil
+		})
+		s, err := Retry(flaky, 3)("ok")
+		is.NoError(err)
+		is.Equal("OK", s)
+		is.Equal(3, calls)
+
+		calls = 0
+		_, err = Retry(flaky, 2)("ok")
+		is.EqualError(err, "unavailable")
+		is.Equal(2, calls)
+
+		calls = 0
+		_, _ = Retry(flaky, 0)("ok")
+		is.Equal(1, calls)
+	})
+
+	t.Run("ToResultFunc", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		is.Equal(Succeed[int, error](2), ToResultFunc(half)(4))
+		is.True(ToResultFunc(half)(3).Failure())
+	})
+}
+
+func TestToMaybeFunc(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	find := ToMaybeFunc(Nilable[string](func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}))
+	is.Equal(Some("x"), find("x"))
+	is.Equal(None[string](), find(""))
+}
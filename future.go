@@ -1,6 +1,10 @@
 package monad
 
-import "sync"
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
 
 // Future represents a monadic interface for future asynchronous computations.
 //
@@ -15,13 +19,22 @@ type Future[T, E any] interface {
 
 	// FlatMap composes this Future operation with another, yielding a new Future.
 	FlatMap(func(T) Future[T, E]) Future[T, E]
+
+	// Channel awaits the Future in a new goroutine and returns a channel
+	// receiving its Result, which is then closed. If the Future panics, the
+	// channel is closed without receiving anything: receive from it with the
+	// comma-ok form, and call Await when it is closed empty to re-raise the
+	// panic, rather than using the nil Result received from the closed channel.
+	Channel() <-chan Result[T, E]
 }
 
 // future is a concrete implementation of the Future interface.
 type future[T, E any] struct {
-	action func() Result[T, E]
-	result Result[T, E]
-	once   sync.Once
+	action   func() Result[T, E]
+	result   Result[T, E]
+	panicked *PanicError
+	done     atomic.Bool
+	once     sync.Once
 }
 
 // NewFuture constructs a new Future Monad.
@@ -30,10 +43,23 @@ func NewFuture[T, E any](action func() Result[T, E]) Future[T, E] {
 }
 
 // Await waits for the Future to be completed and returns the Result.
+//
+// If the action panics, the panic is memoized and re-raised as a *PanicError on
+// every call to Await, rather than leaving the Future without a result. Use
+// RecoverFuture to turn such panics into failures.
 func (f *future[T, E]) Await() Result[T, E] {
 	f.once.Do(func() {
+		defer f.done.Store(true)
+		defer func() {
+			if r := recover(); r != nil {
+				f.panicked = asPanicError(r)
+			}
+		}()
 		f.result = f.action()
 	})
+	if f.panicked != nil {
+		panic(f.panicked)
+	}
 	return f.result
 }
 
@@ -59,3 +85,42 @@ func (f *future[T, E]) FlatMap(compose func(T) Future[T, E]) Future[T, E] {
 		return nextFuture.Await()
 	})
 }
+
+// Channel awaits the Future in a new goroutine, sending its Result on the
+// returned channel before closing it. If the action panics, the panic is
+// recovered in that goroutine and the channel is closed without receiving
+// anything, while Await keeps re-raising the panic.
+func (f *future[T, E]) Channel() <-chan Result[T, E] {
+	ch := make(chan Result[T, E], 1)
+	go func() {
+		defer close(ch)
+		defer func() { _ = recover() }()
+		ch <- f.Await()
+	}()
+	return ch
+}
+
+// String describes the Future without awaiting it.
+func (f *future[T, E]) String() string {
+	return fmt.Sprint(f)
+}
+
+// GoString describes the Future without awaiting it.
+func (f *future[T, E]) GoString() string {
+	return fmt.Sprintf("%#v", f)
+}
+
+// Format implements fmt.Formatter. The Future is never awaited: its Result is
+// only printed once it has been computed, and it is described as pending
+// otherwise.
+func (f *future[T, E]) Format(s fmt.State, verb rune) {
+	formatLazy(s, verb, "Future", typeName[T](), typeName[E]())
+	switch {
+	case !f.done.Load():
+		_, _ = fmt.Fprint(s, "(pending)")
+	case f.panicked != nil:
+		_, _ = fmt.Fprintf(s, "(%s)", f.panicked)
+	default:
+		_, _ = fmt.Fprintf(s, "("+fmt.FormatString(s, verb)+")", f.result)
+	}
+}
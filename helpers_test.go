@@ -0,0 +1,81 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+)
+
+var errTest = errors.New("boom")
+
+// probe builds IOs counting the times they are performed, for the tests of the
+// effects wrapping IOs. The IOs of a gated probe wait for it to be released
+// before completing.
+type probe struct {
+	performed atomic.Int32
+	started   chan struct{}
+	gate      chan struct{}
+}
+
+// newProbe creates a probe whose IOs complete right away.
+func newProbe() *probe {
+	return &probe{started: make(chan struct{}, 1024)}
+}
+
+// newGatedProbe creates a probe whose IOs complete once it is released.
+func newGatedProbe() *probe {
+	p := newProbe()
+	p.gate = make(chan struct{})
+	return p
+}
+
+// io returns an IO succeeding with the number of times the IOs of p were
+// performed, itself included, unless that number is one of failures, in which
+// case it fails with an error naming that number.
+func (p *probe) io(failures ...int) IO[int, error] {
+	return NewIO(func() Result[int, error] {
+		n := p.perform()
+		if slices.Contains(failures, n) {
+			return Fail[int](fmt.Errorf("failure %d", n))
+		}
+		return Succeed[int, error](n)
+	})
+}
+
+// failing returns an IO failing with errTest.
+func (p *probe) failing() IO[int, error] {
+	return NewIO(func() Result[int, error] {
+		p.perform()
+		return Fail[int](errTest)
+	})
+}
+
+// perform counts a performance, then waits for p to be released if it is
+// gated. It returns the number of performances.
+func (p *probe) perform() int {
+	n := int(p.performed.Add(1))
+	select {
+	case p.started <- struct{}{}:
+	default:
+	}
+	if p.gate != nil {
+		<-p.gate
+	}
+	return n
+}
+
+// count returns the number of times the IOs of p were performed.
+func (p *probe) count() int {
+	return int(p.performed.Load())
+}
+
+// awaitStart waits for an IO of p to be performed.
+func (p *probe) awaitStart() {
+	<-p.started
+}
+
+// release lets the IOs of a gated probe complete.
+func (p *probe) release() {
+	close(p.gate)
+}
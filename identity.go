@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // Identity is a generic interface representing the Identity monad.
 type Identity[T any] interface {
 	// Value returns the encapsulated value of the Identity monad.
@@ -36,3 +38,18 @@ func (i identity[T]) Map(f func(T) any) Identity[any] {
 func (i identity[T]) FlatMap(f func(T) Identity[T]) Identity[T] {
 	return f(i.value)
 }
+
+// String formats the Identity as Identity(value).
+func (i identity[T]) String() string {
+	return fmt.Sprint(i)
+}
+
+// GoString formats the Identity as a call to NewIdentity.
+func (i identity[T]) GoString() string {
+	return fmt.Sprintf("%#v", i)
+}
+
+// Format implements fmt.Formatter, applying the verb to the encapsulated value.
+func (i identity[T]) Format(f fmt.State, verb rune) {
+	formatCase(f, verb, "Identity", generic("NewIdentity", typeName[T]()), i.value)
+}
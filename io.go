@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // IO represents a monadic interface for IO operations.
 //
 // T is the type of the value that the IO operation produces.
@@ -16,40 +18,71 @@ type IO[T, E any] interface {
 
 // io is a concrete implementation of the IO interface.
 type io[T, E any] struct {
-	// ioFunc is a function that performs the actual IO operation.
-	// It returns a Result monad containing either the computed value or an error.
-	ioFunc func() Result[T, E]
+	// step describes the IO operation as a Trampoline, so that long chains of
+	// FlatMap run in constant stack space. The Trampoline produces a Result
+	// monad containing either the computed value or an error.
+	step func() Trampoline[Result[T, E]]
 }
 
 // NewIO constructs a new IO monad.
 func NewIO[T, E any](ioFunc func() Result[T, E]) IO[T, E] {
-	return io[T, E]{ioFunc: ioFunc}
+	return io[T, E]{step: func() Trampoline[Result[T, E]] {
+		return Done(ioFunc())
+	}}
 }
 
 // Perform executes the encapsulated IO operation and returns a Result.
 func (i io[T, E]) Perform() Result[T, E] {
-	return i.ioFunc()
+	return i.step().Run()
 }
 
 // Map applies a function to the result of the IO operation.
 func (i io[T, E]) Map(f func(T) any) IO[any, E] {
-	return io[any, E]{ioFunc: func() Result[any, E] {
-		res := i.Perform()
-		if res.Failure() {
-			return Fail[any, E](res.Error())
-		}
-		return Succeed[any, E](f(res.Value()))
+	return io[any, E]{step: func() Trampoline[Result[any, E]] {
+		return mapTrampoline(ioStep[T, E](i), func(res Result[T, E]) Result[any, E] {
+			if res.Failure() {
+				return Fail[any, E](res.Error())
+			}
+			return Succeed[any, E](f(res.Value()))
+		})
 	}}
 }
 
 // FlatMap composes this IO operation with another.
 func (i io[T, E]) FlatMap(f func(T) IO[T, E]) IO[T, E] {
-	return io[T, E]{ioFunc: func() Result[T, E] {
-		res := i.Perform()
-		if res.Failure() {
-			return Fail[T, E](res.Error())
-		}
-		nextIO := f(res.Value())
-		return nextIO.Perform()
+	return io[T, E]{step: func() Trampoline[Result[T, E]] {
+		return ioStep[T, E](i).FlatMap(func(res Result[T, E]) Trampoline[Result[T, E]] {
+			if res.Failure() {
+				return Done(Fail[T, E](res.Error()))
+			}
+			return ioStep(f(res.Value()))
+		})
 	}}
 }
+
+// ioStep returns the operation of i as a Trampoline, deferring it so that it
+// runs within the loop of the Trampoline.
+func ioStep[T, E any](i IO[T, E]) Trampoline[Result[T, E]] {
+	return More(func() Trampoline[Result[T, E]] {
+		if impl, ok := i.(io[T, E]); ok {
+			return impl.step()
+		}
+		return Done(i.Perform())
+	})
+}
+
+// String describes the IO without running it.
+func (i io[T, E]) String() string {
+	return fmt.Sprint(i)
+}
+
+// GoString describes the IO without running it.
+func (i io[T, E]) GoString() string {
+	return fmt.Sprintf("%#v", i)
+}
+
+// Format implements fmt.Formatter. Since the IO is lazy, only its type is
+// described.
+func (i io[T, E]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "IO", typeName[T](), typeName[E]())
+}
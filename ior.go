@@ -0,0 +1,246 @@
+package monad
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Ior is an inclusive-or of a left value of type E and a right value of type
+// T: it holds either a left value, a right value, or both. It models
+// computations yielding a usable value along with non-fatal issues, such as
+// warnings, which Result and Validation force to choose between.
+//
+// FlatMap keeps going when an Ior holds both values, combining the left
+// values met along the way with the Semigroup the Ior was created with. It
+// only stops on an Ior holding a left value alone.
+type Ior[E, T any] interface {
+	// IsLeft returns true if the Ior only holds a left value.
+	IsLeft() bool
+
+	// IsRight returns true if the Ior only holds a right value.
+	IsRight() bool
+
+	// IsBoth returns true if the Ior holds both a left and a right value.
+	IsBoth() bool
+
+	// Left returns the left value, if any.
+	Left() Maybe[E]
+
+	// Right returns the right value, if any.
+	Right() Maybe[T]
+
+	// Map applies a transformation to the right value, if any.
+	Map(func(T) any) Ior[E, any]
+
+	// FlatMap chains an Ior computed from the right value, if any, combining
+	// the left values of both Iors.
+	FlatMap(func(T) Ior[E, T]) Ior[E, T]
+
+	// Warn adds a left value to the Ior, combining it with the current one.
+	Warn(E) Ior[E, T]
+
+	// ToValidation converts the Ior to a Validation, which is invalid only if
+	// the Ior holds a left value alone.
+	ToValidation() Validation[E, T]
+
+	// ToResult converts the Ior to a Result, which is a failure only if the
+	// Ior holds a left value alone.
+	ToResult() Result[T, E]
+
+	// ToStrictResult converts the Ior to a Result, which is a failure whenever
+	// the Ior holds a left value.
+	ToStrictResult() Result[T, E]
+}
+
+// iorSide tells which values an ior holds.
+type iorSide int
+
+const (
+	iorLeft iorSide = iota
+	iorRight
+	iorBoth
+)
+
+// ior is the concrete implementation of the Ior interface.
+type ior[E, T any] struct {
+	side      iorSide      // The values held
+	left      E            // The left value, if any
+	right     T            // The right value, if any
+	semigroup Semigroup[E] // The Semigroup combining left values
+}
+
+// NewIorLeft creates an Ior holding a left value alone. Left values are
+// combined with s.
+func NewIorLeft[E, T any](s Semigroup[E], left E) Ior[E, T] {
+	return ior[E, T]{side: iorLeft, left: left, semigroup: s}
+}
+
+// NewIorRight creates an Ior holding a right value alone. Left values added
+// later are combined with s.
+func NewIorRight[E, T any](s Semigroup[E], right T) Ior[E, T] {
+	return ior[E, T]{side: iorRight, right: right, semigroup: s}
+}
+
+// NewIorBoth creates an Ior holding both a left and a right value. Left values
+// are combined with s.
+func NewIorBoth[E, T any](s Semigroup[E], left E, right T) Ior[E, T] {
+	return ior[E, T]{side: iorBoth, left: left, right: right, semigroup: s}
+}
+
+// MatchIor calls the handler matching the values held by i and returns its
+// result.
+func MatchIor[E, T, R any](
+	i Ior[E, T], onLeft func(E) R, onRight func(T) R, onBoth func(E, T) R,
+) R {
+	switch {
+	case i.IsLeft():
+		return onLeft(i.Left().Value())
+	case i.IsRight():
+		return onRight(i.Right().Value())
+	default:
+		return onBoth(i.Left().Value(), i.Right().Value())
+	}
+}
+
+// IsLeft checks if the ior only holds a left value.
+func (i ior[E, T]) IsLeft() bool {
+	return i.side == iorLeft
+}
+
+// IsRight checks if the ior only holds a right value.
+func (i ior[E, T]) IsRight() bool {
+	return i.side == iorRight
+}
+
+// IsBoth checks if the ior holds both values.
+func (i ior[E, T]) IsBoth() bool {
+	return i.side == iorBoth
+}
+
+// Left returns the left value of the ior, if any.
+func (i ior[E, T]) Left() Maybe[E] {
+	if i.side == iorRight {
+		return None[E]()
+	}
+	return Some(i.left)
+}
+
+// Right returns the right value of the ior, if any.
+func (i ior[E, T]) Right() Maybe[T] {
+	if i.side == iorLeft {
+		return None[T]()
+	}
+	return Some(i.right)
+}
+
+// Map applies f to the right value of the ior, if any.
+func (i ior[E, T]) Map(f func(T) any) Ior[E, any] {
+	mapped := ior[E, any]{side: i.side, left: i.left, semigroup: i.semigroup}
+	if i.side != iorLeft {
+		mapped.right = f(i.right)
+	}
+	return mapped
+}
+
+// FlatMap chains f after the right value of the ior, if any. When the ior
+// holds both values, its left value is combined with the one returned by f.
+func (i ior[E, T]) FlatMap(f func(T) Ior[E, T]) Ior[E, T] {
+	if i.side == iorLeft {
+		return i
+	}
+	next := f(i.right)
+	if i.side == iorRight {
+		return next
+	}
+	result := ior[E, T]{side: iorBoth, left: i.left, semigroup: i.semigroup}
+	if next.IsLeft() {
+		result.side = iorLeft
+	} else {
+		result.right = next.Right().Value()
+	}
+	if !next.IsRight() {
+		result.left = i.semigroup.Combine(i.left, next.Left().Value())
+	}
+	return result
+}
+
+// Warn adds warning to the left value of the ior.
+func (i ior[E, T]) Warn(warning E) Ior[E, T] {
+	switch i.side {
+	case iorRight:
+		i.side, i.left = iorBoth, warning
+	default:
+		i.left = i.semigroup.Combine(i.left, warning)
+	}
+	return i
+}
+
+// ToValidation converts the ior to a Validation, dropping the left value of an
+// ior holding both values.
+func (i ior[E, T]) ToValidation() Validation[E, T] {
+	if i.side == iorLeft {
+		return NewInvalid[E, T](i.left)
+	}
+	return NewValid[E](i.right)
+}
+
+// ToResult converts the ior to a Result, dropping the left value of an ior
+// holding both values.
+func (i ior[E, T]) ToResult() Result[T, E] {
+	if i.side == iorLeft {
+		return Fail[T](i.left)
+	}
+	return Succeed[T, E](i.right)
+}
+
+// ToStrictResult converts the ior to a Result, failing with the left value of
+// an ior holding both values.
+func (i ior[E, T]) ToStrictResult() Result[T, E] {
+	if i.side == iorRight {
+		return Succeed[T, E](i.right)
+	}
+	return Fail[T](i.left)
+}
+
+// String formats the ior as Left(left), Right(right) or Both(left, right).
+func (i ior[E, T]) String() string {
+	return fmt.Sprint(i)
+}
+
+// GoString formats the ior as a call to NewIorLeft, NewIorRight or NewIorBoth,
+// omitting the Semigroup.
+func (i ior[E, T]) GoString() string {
+	return fmt.Sprintf("%#v", i)
+}
+
+// Format implements fmt.Formatter, applying the verb to the values held, which
+// are labeled when formatted with %+v.
+func (i ior[E, T]) Format(f fmt.State, verb rune) {
+	switch i.side {
+	case iorLeft:
+		formatCase(f, verb, "Left", generic("NewIorLeft", typeName[E](), typeName[T]()), i.left)
+	case iorRight:
+		formatCase(f, verb, "Right", generic("NewIorRight", typeName[E](), typeName[T]()), i.right)
+	default:
+		formatCase(
+			f, verb, "Both", generic("NewIorBoth", typeName[E](), typeName[T]()),
+			labeled{label: "left", value: i.left},
+			labeled{label: "right", value: i.right},
+		)
+	}
+}
+
+// LogValue implements slog.LogValuer, logging the ior as a group holding its
+// side and the values it holds.
+func (i ior[E, T]) LogValue() slog.Value {
+	switch i.side {
+	case iorLeft:
+		return slog.GroupValue(slog.String("side", "left"), slog.Any("left", i.left))
+	case iorRight:
+		return slog.GroupValue(slog.String("side", "right"), slog.Any("right", i.right))
+	default:
+		return slog.GroupValue(
+			slog.String("side", "both"), slog.Any("left", i.left), slog.Any("right", i.right),
+		)
+	}
+}
@@ -0,0 +1,132 @@
+package monad
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type warnings = []string
+
+func TestIorCases(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	s := MonoidSlice[string]()
+	left := NewIorLeft[warnings, int](s, warnings{"w"})
+	right := NewIorRight[warnings](s, 1)
+	both := NewIorBoth(s, warnings{"w"}, 1)
+
+	is.True(left.IsLeft())
+	is.False(left.IsRight() || left.IsBoth())
+	is.Equal(Some(warnings{"w"}), left.Left())
+	is.True(left.Right().Nothing())
+
+	is.True(right.IsRight())
+	is.True(right.Left().Nothing())
+	is.Equal(Some(1), right.Right())
+
+	is.True(both.IsBoth())
+	is.Equal(Some(warnings{"w"}), both.Left())
+	is.Equal(Some(1), both.Right())
+
+	describe := func(i Ior[warnings, int]) string {
+		return MatchIor(
+			i,
+			func(w warnings) string { return "left " + strings.Join(w, ",") },
+			func(x int) string { return fmt.Sprint("right ", x) },
+			func(w warnings, x int) string { return fmt.Sprint("both ", strings.Join(w, ","), " ", x) },
+		)
+	}
+	is.Equal("left w", describe(left))
+	is.Equal("right 1", describe(right))
+	is.Equal("both w 1", describe(both))
+}
+
+func TestIorFlatMapAccumulatesLeftValues(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	s := MonoidSlice[string]()
+	parse := func(x int) Ior[warnings, int] {
+		if x < 0 {
+			return NewIorLeft[warnings, int](s, warnings{"negative"})
+		}
+		if x > 10 {
+			return NewIorBoth(s, warnings{"clamped"}, 10)
+		}
+		return NewIorRight[warnings](s, x)
+	}
+
+	r := NewIorBoth(s, warnings{"first"}, 20).FlatMap(parse)
+	is.Equal(Some(warnings{"first", "clamped"}), r.Left())
+	is.Equal(Some(10), r.Right())
+
+	r = NewIorBoth(s, warnings{"first"}, 5).FlatMap(parse)
+	is.True(r.IsBoth())
+	is.Equal(Some(warnings{"first"}), r.Left())
+
+	r = NewIorBoth(s, warnings{"first"}, -1).FlatMap(parse)
+	is.True(r.IsLeft())
+	is.Equal(Some(warnings{"first", "negative"}), r.Left())
+
+	calls := 0
+	r = NewIorLeft[warnings, int](s, warnings{"fatal"}).FlatMap(func(x int) Ior[warnings, int] {
+		calls++
+		return parse(x)
+	})
+	is.Equal(0, calls)
+	is.Equal(Some(warnings{"fatal"}), r.Left())
+
+	is.Equal(Some[any]("2"), NewIorBoth(s, warnings{"w"}, 2).Map(func(x int) any { return fmt.Sprint(x) }).Right())
+}
+
+func TestIorWarn(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	s := MonoidSlice[string]()
+	i := NewIorRight[warnings](s, 1).Warn(warnings{"a"}).Warn(warnings{"b"})
+	is.True(i.IsBoth())
+	is.Equal(Some(warnings{"a", "b"}), i.Left())
+	is.Equal(Some(warnings{"x", "y"}), NewIorLeft[warnings, int](s, warnings{"x"}).Warn(warnings{"y"}).Left())
+}
+
+func TestIorConversions(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	s := NewSemigroup(func(a, b string) string { return a + "; " + b })
+	left := NewIorLeft[string, int](s, "fatal")
+	both := NewIorBoth(s, "warning", 1)
+	right := NewIorRight[string](s, 1)
+
+	is.Equal(NewInvalid[string, int]("fatal"), left.ToValidation())
+	is.Equal(NewValid[string](1), both.ToValidation())
+	is.Equal(NewValid[string](1), right.ToValidation())
+
+	is.Equal(Fail[int]("fatal"), left.ToResult())
+	is.Equal(Succeed[int, string](1), both.ToResult())
+	is.Equal(Fail[int]("warning"), both.ToStrictResult())
+	is.Equal(Succeed[int, string](1), right.ToStrictResult())
+}
+
+func TestIorKind(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	s := MonoidSlice[string]()
+	m := IorMonad(s)
+	checked := Traverse(m, NewList([]int{1, 20, 3}), func(x int) Kind[IorKind[warnings], int] {
+		if x > 10 {
+			return IorToKind(NewIorBoth(s, warnings{fmt.Sprint(x, " is large")}, x))
+		}
+		return Pure[IorKind[warnings]](m, x)
+	})
+	i := KindToIor(checked)
+	is.Equal(Some(warnings{"20 is large"}), i.Left())
+	is.Equal([]int{1, 20, 3}, i.Right().Value().Values())
+	is.Equal(Some(warnings{"20 is large", "more"}), i.Warn(warnings{"more"}).Left())
+}
@@ -0,0 +1,702 @@
+package monad
+
+import (
+	"context"
+	"fmt"
+)
+
+// Kind is the type constructor identified by the brand F applied to the type
+// A. For instance, Kind[MaybeKind, int] stands for Maybe[int]. Go has no
+// higher-kinded types, so Kind makes it possible to write functions once for
+// every monad, such as Traverse or FoldM, by abstracting over the brand.
+//
+// A Kind holds the monad with its values erased to any. The <Monad>ToKind and
+// KindTo<Monad> functions convert between a monad and its Kind.
+type Kind[F, A any] struct {
+	repr any
+}
+
+// NewKind wraps the representation of a monad whose values are erased to any,
+// such as a Maybe[any] for MaybeKind. It is meant for implementing a
+// MonadInstance for a monad defined outside of this package.
+func NewKind[F, A any](repr any) Kind[F, A] {
+	return Kind[F, A]{repr: repr}
+}
+
+// Repr returns the representation of the monad held by the Kind, with its
+// values erased to any.
+func (k Kind[F, A]) Repr() any {
+	return k.repr
+}
+
+// MonadInstance is the dictionary of the monad operations of the type
+// constructor identified by the brand F. Since Go methods cannot have type
+// parameters, the operations work on values erased to any. Use the Pure,
+// MapKind and FlatMapKind functions to call them with typed values.
+type MonadInstance[F any] interface {
+	// Pure wraps a value in the monad.
+	Pure(a any) Kind[F, any]
+
+	// FlatMap chains a computation returning a monad after m.
+	FlatMap(m Kind[F, any], f func(any) Kind[F, any]) Kind[F, any]
+}
+
+// Pure wraps a value in the monad described by m.
+func Pure[F, A any](m MonadInstance[F], a A) Kind[F, A] {
+	return Kind[F, A]{repr: m.Pure(a).repr}
+}
+
+// FlatMapKind chains f after k in the monad described by m.
+func FlatMapKind[F, A, B any](m MonadInstance[F], k Kind[F, A], f func(A) Kind[F, B]) Kind[F, B] {
+	return Kind[F, B]{repr: m.FlatMap(Kind[F, any]{repr: k.repr}, func(a any) Kind[F, any] {
+		return Kind[F, any]{repr: f(cast[A](a)).repr}
+	}).repr}
+}
+
+// MapKind applies f to the values of k in the monad described by m.
+func MapKind[F, A, B any](m MonadInstance[F], k Kind[F, A], f func(A) B) Kind[F, B] {
+	return FlatMapKind(m, k, func(a A) Kind[F, B] {
+		return Pure(m, f(a))
+	})
+}
+
+// cast converts an erased value back to its type. A nil value, which an
+// erased nil interface becomes, yields the zero value of T.
+func cast[T any](x any) T {
+	v, _ := x.(T)
+	return v
+}
+
+// MaybeKind is the brand of Maybe.
+type MaybeKind struct{}
+
+type maybeInstance struct{}
+
+// MaybeMonad returns the MonadInstance of Maybe.
+func MaybeMonad() MonadInstance[MaybeKind] {
+	return maybeInstance{}
+}
+
+func (maybeInstance) Pure(a any) Kind[MaybeKind, any] {
+	return Kind[MaybeKind, any]{repr: Some(a)}
+}
+
+func (maybeInstance) FlatMap(
+	m Kind[MaybeKind, any], f func(any) Kind[MaybeKind, any],
+) Kind[MaybeKind, any] {
+	return Kind[MaybeKind, any]{repr: m.repr.(Maybe[any]).FlatMap(func(a any) Maybe[any] {
+		return f(a).repr.(Maybe[any])
+	})}
+}
+
+// MaybeToKind converts a Maybe to its Kind.
+func MaybeToKind[A any](m Maybe[A]) Kind[MaybeKind, A] {
+	return Kind[MaybeKind, A]{repr: m.Map(func(a A) Maybe[any] { return Some[any](a) })}
+}
+
+// KindToMaybe converts a Kind back to a Maybe.
+func KindToMaybe[A any](k Kind[MaybeKind, A]) Maybe[A] {
+	m := k.repr.(Maybe[any])
+	if m.Nothing() {
+		return None[A]()
+	}
+	return Some(cast[A](m.Value()))
+}
+
+// ResultKind is the brand of Result with errors of type E.
+type ResultKind[E any] struct{}
+
+type resultInstance[E any] struct{}
+
+// ResultMonad returns the MonadInstance of Result with errors of type E.
+func ResultMonad[E any]() MonadInstance[ResultKind[E]] {
+	return resultInstance[E]{}
+}
+
+func (resultInstance[E]) Pure(a any) Kind[ResultKind[E], any] {
+	return Kind[ResultKind[E], any]{repr: Succeed[any, E](a)}
+}
+
+func (resultInstance[E]) FlatMap(
+	m Kind[ResultKind[E], any], f func(any) Kind[ResultKind[E], any],
+) Kind[ResultKind[E], any] {
+	return Kind[ResultKind[E], any]{repr: m.repr.(Result[any, E]).FlatMap(func(a any) Result[any, E] {
+		return f(a).repr.(Result[any, E])
+	})}
+}
+
+// ResultToKind converts a Result to its Kind.
+func ResultToKind[A, E any](r Result[A, E]) Kind[ResultKind[E], A] {
+	return Kind[ResultKind[E], A]{repr: r.Map(func(a A) any { return a })}
+}
+
+// KindToResult converts a Kind back to a Result.
+func KindToResult[A, E any](k Kind[ResultKind[E], A]) Result[A, E] {
+	return narrowResult[A](k.repr.(Result[any, E]))
+}
+
+// narrowResult converts a Result holding erased values back to its type.
+func narrowResult[A, E any](r Result[any, E]) Result[A, E] {
+	if r.Failure() {
+		return Fail[A](r.Error())
+	}
+	return Succeed[A, E](cast[A](r.Value()))
+}
+
+// EitherKind is the brand of Either.
+type EitherKind struct{}
+
+type eitherInstance struct{}
+
+// EitherMonad returns the MonadInstance of Either, which chains right values
+// and short-circuits on left values.
+func EitherMonad() MonadInstance[EitherKind] {
+	return eitherInstance{}
+}
+
+func (eitherInstance) Pure(a any) Kind[EitherKind, any] {
+	return Kind[EitherKind, any]{repr: NewRVal(a)}
+}
+
+func (eitherInstance) FlatMap(
+	m Kind[EitherKind, any], f func(any) Kind[EitherKind, any],
+) Kind[EitherKind, any] {
+	return Kind[EitherKind, any]{repr: m.repr.(Either[any]).FlatMap(func(a any) Either[any] {
+		return f(a).repr.(Either[any])
+	})}
+}
+
+// EitherToKind converts an Either to its Kind.
+func EitherToKind[A any](e Either[A]) Kind[EitherKind, A] {
+	if e.Left() {
+		return Kind[EitherKind, A]{repr: NewLVal[any](e.Value())}
+	}
+	return Kind[EitherKind, A]{repr: NewRVal[any](e.Value())}
+}
+
+// KindToEither converts a Kind back to an Either.
+func KindToEither[A any](k Kind[EitherKind, A]) Either[A] {
+	e := k.repr.(Either[any])
+	if e.Left() {
+		return NewLVal(cast[A](e.Value()))
+	}
+	return NewRVal(cast[A](e.Value()))
+}
+
+// ListKind is the brand of List.
+type ListKind struct{}
+
+type listInstance struct{}
+
+// ListMonad returns the MonadInstance of List.
+func ListMonad() MonadInstance[ListKind] {
+	return listInstance{}
+}
+
+func (listInstance) Pure(a any) Kind[ListKind, any] {
+	return Kind[ListKind, any]{repr: NewList([]any{a})}
+}
+
+func (listInstance) FlatMap(m Kind[ListKind, any], f func(any) Kind[ListKind, any]) Kind[ListKind, any] {
+	return Kind[ListKind, any]{repr: m.repr.(List[any]).FlatMap(func(a any) List[any] {
+		return f(a).repr.(List[any])
+	})}
+}
+
+// ListToKind converts a List to its Kind.
+func ListToKind[A any](l List[A]) Kind[ListKind, A] {
+	return Kind[ListKind, A]{repr: l.Map(func(a A) any { return a })}
+}
+
+// KindToList converts a Kind back to a List.
+func KindToList[A any](k Kind[ListKind, A]) List[A] {
+	values := k.repr.(List[any]).Values()
+	result := make([]A, len(values))
+	for i, v := range values {
+		result[i] = cast[A](v)
+	}
+	return NewList(result)
+}
+
+// IdentityKind is the brand of Identity.
+type IdentityKind struct{}
+
+type identityInstance struct{}
+
+// IdentityMonad returns the MonadInstance of Identity.
+func IdentityMonad() MonadInstance[IdentityKind] {
+	return identityInstance{}
+}
+
+func (identityInstance) Pure(a any) Kind[IdentityKind, any] {
+	return Kind[IdentityKind, any]{repr: NewIdentity(a)}
+}
+
+func (identityInstance) FlatMap(
+	m Kind[IdentityKind, any], f func(any) Kind[IdentityKind, any],
+) Kind[IdentityKind, any] {
+	return Kind[IdentityKind, any]{repr: m.repr.(Identity[any]).FlatMap(func(a any) Identity[any] {
+		return f(a).repr.(Identity[any])
+	})}
+}
+
+// IdentityToKind converts an Identity to its Kind.
+func IdentityToKind[A any](i Identity[A]) Kind[IdentityKind, A] {
+	return Kind[IdentityKind, A]{repr: i.Map(func(a A) any { return a })}
+}
+
+// KindToIdentity converts a Kind back to an Identity.
+func KindToIdentity[A any](k Kind[IdentityKind, A]) Identity[A] {
+	return NewIdentity(cast[A](k.repr.(Identity[any]).Value()))
+}
+
+// ReaderKind is the brand of Reader with environments of type R.
+type ReaderKind[R any] struct{}
+
+type readerInstance[R any] struct{}
+
+// ReaderMonad returns the MonadInstance of Reader with environments of type R.
+func ReaderMonad[R any]() MonadInstance[ReaderKind[R]] {
+	return readerInstance[R]{}
+}
+
+func (readerInstance[R]) Pure(a any) Kind[ReaderKind[R], any] {
+	return Kind[ReaderKind[R], any]{repr: NewReader(func(R) any { return a })}
+}
+
+func (readerInstance[R]) FlatMap(
+	m Kind[ReaderKind[R], any], f func(any) Kind[ReaderKind[R], any],
+) Kind[ReaderKind[R], any] {
+	return Kind[ReaderKind[R], any]{repr: m.repr.(Reader[R, any]).FlatMap(func(a any) Reader[R, any] {
+		return f(a).repr.(Reader[R, any])
+	})}
+}
+
+// ReaderToKind converts a Reader to its Kind.
+func ReaderToKind[R, A any](r Reader[R, A]) Kind[ReaderKind[R], A] {
+	return Kind[ReaderKind[R], A]{repr: r.Map(func(a A) any { return a })}
+}
+
+// KindToReader converts a Kind back to a Reader.
+func KindToReader[R, A any](k Kind[ReaderKind[R], A]) Reader[R, A] {
+	r := k.repr.(Reader[R, any])
+	return NewReader(func(env R) A { return cast[A](r.Run(env)) })
+}
+
+// WriterKind is the brand of Writer with outputs of type W.
+type WriterKind[W any] struct{}
+
+type writerInstance[W any] struct {
+	monoid Monoid[W]
+}
+
+// WriterMonad returns the MonadInstance of Writer with outputs of type W. Pure
+// creates Writers with the empty output of m, combining outputs with m.
+func WriterMonad[W any](m Monoid[W]) MonadInstance[WriterKind[W]] {
+	return writerInstance[W]{monoid: m}
+}
+
+func (m writerInstance[W]) Pure(a any) Kind[WriterKind[W], any] {
+	return Kind[WriterKind[W], any]{repr: NewWriterWith(a, m.monoid.Empty(), m.monoid)}
+}
+
+func (writerInstance[W]) FlatMap(
+	m Kind[WriterKind[W], any], f func(any) Kind[WriterKind[W], any],
+) Kind[WriterKind[W], any] {
+	return Kind[WriterKind[W], any]{repr: m.repr.(Writer[W, any]).FlatMap(func(a any) Writer[W, any] {
+		return f(a).repr.(Writer[W, any])
+	})}
+}
+
+// WriterToKind converts a Writer to its Kind.
+func WriterToKind[W, A any](w Writer[W, A]) Kind[WriterKind[W], A] {
+	return Kind[WriterKind[W], A]{repr: w.Map(func(a A) any { return a })}
+}
+
+// KindToWriter converts a Kind back to a Writer, keeping the way it combines
+// outputs.
+func KindToWriter[W, A any](k Kind[WriterKind[W], A]) Writer[W, A] {
+	w := k.repr.(Writer[W, any])
+	if impl, ok := w.(writer[W, any]); ok {
+		return writer[W, A]{value: cast[A](impl.value), output: impl.output, combine: impl.combine}
+	}
+	return narrowWriter[W, A]{erased: w}
+}
+
+// narrowWriter is a Writer of another implementation holding an erased value,
+// seen as a Writer of values of type A. It delegates to the erased Writer, so
+// that outputs are still combined the way it combines them.
+type narrowWriter[W, A any] struct {
+	erased Writer[W, any]
+}
+
+func (w narrowWriter[W, A]) Value() A  { return cast[A](w.erased.Value()) }
+func (w narrowWriter[W, A]) Output() W { return w.erased.Output() }
+
+func (w narrowWriter[W, A]) Run() (A, W) {
+	value, output := w.erased.Run()
+	return cast[A](value), output
+}
+
+func (w narrowWriter[W, A]) Map(f func(A) any) Writer[W, any] {
+	return w.erased.Map(func(a any) any { return f(cast[A](a)) })
+}
+
+func (w narrowWriter[W, A]) FlatMap(f func(A) Writer[W, A]) Writer[W, A] {
+	return narrowWriter[W, A]{erased: w.erased.FlatMap(func(a any) Writer[W, any] {
+		return WriterToKind(f(cast[A](a))).repr.(Writer[W, any])
+	})}
+}
+
+// Format formats the erased Writer.
+func (w narrowWriter[W, A]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), w.erased)
+}
+
+// StateKind is the brand of State with states of type S.
+type StateKind[S any] struct{}
+
+type stateInstance[S any] struct{}
+
+// StateMonad returns the MonadInstance of State with states of type S.
+func StateMonad[S any]() MonadInstance[StateKind[S]] {
+	return stateInstance[S]{}
+}
+
+func (stateInstance[S]) Pure(a any) Kind[StateKind[S], any] {
+	return Kind[StateKind[S], any]{repr: NewState(func(s S) (any, S) { return a, s })}
+}
+
+func (stateInstance[S]) FlatMap(
+	m Kind[StateKind[S], any], f func(any) Kind[StateKind[S], any],
+) Kind[StateKind[S], any] {
+	return Kind[StateKind[S], any]{repr: m.repr.(State[S, any]).FlatMap(func(a any) State[S, any] {
+		return f(a).repr.(State[S, any])
+	})}
+}
+
+// StateToKind converts a State to its Kind.
+func StateToKind[S, A any](s State[S, A]) Kind[StateKind[S], A] {
+	return Kind[StateKind[S], A]{repr: s.Map(func(a A) any { return a })}
+}
+
+// KindToState converts a Kind back to a State.
+func KindToState[S, A any](k Kind[StateKind[S], A]) State[S, A] {
+	s := k.repr.(State[S, any])
+	return NewState(func(st S) (A, S) {
+		a, next := s.Run(st)
+		return cast[A](a), next
+	})
+}
+
+// IOKind is the brand of IO with errors of type E.
+type IOKind[E any] struct{}
+
+type ioInstance[E any] struct{}
+
+// IOMonad returns the MonadInstance of IO with errors of type E.
+func IOMonad[E any]() MonadInstance[IOKind[E]] {
+	return ioInstance[E]{}
+}
+
+func (ioInstance[E]) Pure(a any) Kind[IOKind[E], any] {
+	return Kind[IOKind[E], any]{repr: NewIO(func() Result[any, E] { return Succeed[any, E](a) })}
+}
+
+func (ioInstance[E]) FlatMap(m Kind[IOKind[E], any], f func(any) Kind[IOKind[E], any]) Kind[IOKind[E], any] {
+	return Kind[IOKind[E], any]{repr: m.repr.(IO[any, E]).FlatMap(func(a any) IO[any, E] {
+		return f(a).repr.(IO[any, E])
+	})}
+}
+
+// IOToKind converts an IO to its Kind.
+func IOToKind[A, E any](i IO[A, E]) Kind[IOKind[E], A] {
+	return Kind[IOKind[E], A]{repr: i.Map(func(a A) any { return a })}
+}
+
+// KindToIO converts a Kind back to an IO.
+func KindToIO[A, E any](k Kind[IOKind[E], A]) IO[A, E] {
+	i := k.repr.(IO[any, E])
+	return NewIO(func() Result[A, E] { return narrowResult[A](i.Perform()) })
+}
+
+// FutureKind is the brand of Future with errors of type E.
+type FutureKind[E any] struct{}
+
+type futureInstance[E any] struct{}
+
+// FutureMonad returns the MonadInstance of Future with errors of type E.
+func FutureMonad[E any]() MonadInstance[FutureKind[E]] {
+	return futureInstance[E]{}
+}
+
+func (futureInstance[E]) Pure(a any) Kind[FutureKind[E], any] {
+	return Kind[FutureKind[E], any]{repr: NewFuture(func() Result[any, E] { return Succeed[any, E](a) })}
+}
+
+func (futureInstance[E]) FlatMap(
+	m Kind[FutureKind[E], any], f func(any) Kind[FutureKind[E], any],
+) Kind[FutureKind[E], any] {
+	return Kind[FutureKind[E], any]{repr: m.repr.(Future[any, E]).FlatMap(func(a any) Future[any, E] {
+		return f(a).repr.(Future[any, E])
+	})}
+}
+
+// FutureToKind converts a Future to its Kind.
+func FutureToKind[A, E any](f Future[A, E]) Kind[FutureKind[E], A] {
+	return Kind[FutureKind[E], A]{repr: f.Map(func(a A) any { return a })}
+}
+
+// KindToFuture converts a Kind back to a Future.
+func KindToFuture[A, E any](k Kind[FutureKind[E], A]) Future[A, E] {
+	f := k.repr.(Future[any, E])
+	return NewFuture(func() Result[A, E] { return narrowResult[A](f.Await()) })
+}
+
+// ValidationKind is the brand of Validation with errors of type E.
+type ValidationKind[E any] struct{}
+
+type validationInstance[E any] struct{}
+
+// ValidationMonad returns the MonadInstance of Validation with errors of type
+// E. Like Validation.FlatMap, it stops at the first invalid value.
+func ValidationMonad[E any]() MonadInstance[ValidationKind[E]] {
+	return validationInstance[E]{}
+}
+
+func (validationInstance[E]) Pure(a any) Kind[ValidationKind[E], any] {
+	return Kind[ValidationKind[E], any]{repr: NewValid[E](a)}
+}
+
+func (validationInstance[E]) FlatMap(
+	m Kind[ValidationKind[E], any], f func(any) Kind[ValidationKind[E], any],
+) Kind[ValidationKind[E], any] {
+	return Kind[ValidationKind[E], any]{repr: m.repr.(Validation[E, any]).FlatMap(func(a any) Validation[E, any] {
+		return f(a).repr.(Validation[E, any])
+	})}
+}
+
+// ValidationToKind converts a Validation to its Kind.
+func ValidationToKind[E, A any](v Validation[E, A]) Kind[ValidationKind[E], A] {
+	return Kind[ValidationKind[E], A]{repr: v.Map(func(a A) any { return a })}
+}
+
+// KindToValidation converts a Kind back to a Validation.
+func KindToValidation[E, A any](k Kind[ValidationKind[E], A]) Validation[E, A] {
+	v := k.repr.(Validation[E, any])
+	if !v.Valid() {
+		return validation[E, A]{errors: v.NonEmptyErrors().Value()}
+	}
+	return NewValid[E](cast[A](v.Value()))
+}
+
+// ContinuationKind is the brand of Continuation.
+type ContinuationKind struct{}
+
+type continuationInstance struct{}
+
+// ContinuationMonad returns the MonadInstance of Continuation.
+func ContinuationMonad() MonadInstance[ContinuationKind] {
+	return continuationInstance{}
+}
+
+func (continuationInstance) Pure(a any) Kind[ContinuationKind, any] {
+	return Kind[ContinuationKind, any]{repr: NewContinuation(func(context.Context) Result[any, error] {
+		return Succeed[any, error](a)
+	})}
+}
+
+func (continuationInstance) FlatMap(
+	m Kind[ContinuationKind, any], f func(any) Kind[ContinuationKind, any],
+) Kind[ContinuationKind, any] {
+	return Kind[ContinuationKind, any]{repr: m.repr.(Continuation[any]).FlatMap(func(a any) Continuation[any] {
+		return f(a).repr.(Continuation[any])
+	})}
+}
+
+// ContinuationToKind converts a Continuation to its Kind.
+func ContinuationToKind[A any](c Continuation[A]) Kind[ContinuationKind, A] {
+	return Kind[ContinuationKind, A]{repr: c.Map(func(a A) any { return a })}
+}
+
+// KindToContinuation converts a Kind back to a Continuation.
+func KindToContinuation[A any](k Kind[ContinuationKind, A]) Continuation[A] {
+	c := k.repr.(Continuation[any])
+	return NewContinuation(func(ctx context.Context) Result[A, error] {
+		return narrowResult[A](c.Run(ctx))
+	})
+}
+
+// EvalKind is the brand of Eval.
+type EvalKind struct{}
+
+type evalInstance struct{}
+
+// EvalMonad returns the MonadInstance of Eval.
+func EvalMonad() MonadInstance[EvalKind] {
+	return evalInstance{}
+}
+
+func (evalInstance) Pure(a any) Kind[EvalKind, any] {
+	return Kind[EvalKind, any]{repr: Now(a)}
+}
+
+func (evalInstance) FlatMap(m Kind[EvalKind, any], f func(any) Kind[EvalKind, any]) Kind[EvalKind, any] {
+	return Kind[EvalKind, any]{repr: m.repr.(Eval[any]).FlatMap(func(a any) Eval[any] {
+		return f(a).repr.(Eval[any])
+	})}
+}
+
+// EvalToKind converts an Eval to its Kind.
+func EvalToKind[A any](e Eval[A]) Kind[EvalKind, A] {
+	return Kind[EvalKind, A]{repr: e.Map(func(a A) any { return a })}
+}
+
+// KindToEval converts a Kind back to an Eval.
+func KindToEval[A any](k Kind[EvalKind, A]) Eval[A] {
+	e := k.repr.(Eval[any])
+	return eval[A]{step: func() Trampoline[A] {
+		return mapTrampoline(evalStep(e), cast[A])
+	}}
+}
+
+// FreeKind is the brand of Free over the functor F.
+type FreeKind[F any] struct{}
+
+type freeInstance[F any] struct{}
+
+// FreeMonad returns the MonadInstance of Free over the functor F.
+func FreeMonad[F any]() MonadInstance[FreeKind[F]] {
+	return freeInstance[F]{}
+}
+
+func (freeInstance[F]) Pure(a any) Kind[FreeKind[F], any] {
+	return Kind[FreeKind[F], any]{repr: NewPure[F](a)}
+}
+
+func (freeInstance[F]) FlatMap(m Kind[FreeKind[F], any], f func(any) Kind[FreeKind[F], any]) Kind[FreeKind[F], any] {
+	return Kind[FreeKind[F], any]{repr: m.repr.(Free[F, any]).FlatMap(func(a any) Free[F, any] {
+		return f(a).repr.(Free[F, any])
+	})}
+}
+
+// FreeToKind converts a Free to its Kind.
+func FreeToKind[F, A any](m Free[F, A]) Kind[FreeKind[F], A] {
+	return Kind[FreeKind[F], A]{repr: m.Map(func(a A) any { return a })}
+}
+
+// KindToFree converts a Kind back to a Free.
+func KindToFree[F, A any](k Kind[FreeKind[F], A]) Free[F, A] {
+	return narrowFree[F, A]{erased: k.repr.(Free[F, any])}
+}
+
+// narrowFree is a Free with an erased result, seen as a Free with a result of
+// type A.
+type narrowFree[F, A any] struct {
+	erased Free[F, any]
+}
+
+// FlatMap chains fn after the erased Free.
+func (m narrowFree[F, A]) FlatMap(fn func(A) Free[F, A]) Free[F, A] {
+	return freeBind[F, A]{sub: m, cont: fn}
+}
+
+// Map transforms the result of the erased Free.
+func (m narrowFree[F, A]) Map(fn func(A) any) Free[F, any] {
+	return freeMap[F, A]{sub: m, fn: fn}
+}
+
+// RunFree interprets the erased Free.
+func (m narrowFree[F, A]) RunFree(interpreter func(F) A) A {
+	return freeStep[F, A](m, interpreter).Run()
+}
+
+func (m narrowFree[F, A]) trampoline(interpreter func(F) A) Trampoline[A] {
+	erasedInterpreter := func(f F) any { return interpreter(f) }
+	return mapTrampoline(freeStep(m.erased, erasedInterpreter), cast[A])
+}
+
+// IorKind is the brand of Ior with left values of type E.
+type IorKind[E any] struct{}
+
+type iorInstance[E any] struct {
+	semigroup Semigroup[E]
+}
+
+// IorMonad returns the MonadInstance of Ior with left values of type E. Pure
+// creates Iors combining left values with s.
+func IorMonad[E any](s Semigroup[E]) MonadInstance[IorKind[E]] {
+	return iorInstance[E]{semigroup: s}
+}
+
+func (m iorInstance[E]) Pure(a any) Kind[IorKind[E], any] {
+	return Kind[IorKind[E], any]{repr: NewIorRight[E](m.semigroup, a)}
+}
+
+func (iorInstance[E]) FlatMap(m Kind[IorKind[E], any], f func(any) Kind[IorKind[E], any]) Kind[IorKind[E], any] {
+	return Kind[IorKind[E], any]{repr: m.repr.(Ior[E, any]).FlatMap(func(a any) Ior[E, any] {
+		return f(a).repr.(Ior[E, any])
+	})}
+}
+
+// IorToKind converts an Ior to its Kind.
+func IorToKind[E, A any](i Ior[E, A]) Kind[IorKind[E], A] {
+	return Kind[IorKind[E], A]{repr: i.Map(func(a A) any { return a })}
+}
+
+// KindToIor converts a Kind back to an Ior, keeping the way it combines left
+// values.
+func KindToIor[E, A any](k Kind[IorKind[E], A]) Ior[E, A] {
+	i := k.repr.(Ior[E, any])
+	if impl, ok := i.(ior[E, any]); ok {
+		return ior[E, A]{side: impl.side, left: impl.left, right: cast[A](impl.right), semigroup: impl.semigroup}
+	}
+	return narrowIor[E, A]{erased: i}
+}
+
+// narrowIor is an Ior of another implementation holding erased right values,
+// seen as an Ior of right values of type A. It delegates to the erased Ior, so
+// that left values are still combined the way it combines them.
+type narrowIor[E, A any] struct {
+	erased Ior[E, any]
+}
+
+func (i narrowIor[E, A]) IsLeft() bool   { return i.erased.IsLeft() }
+func (i narrowIor[E, A]) IsRight() bool  { return i.erased.IsRight() }
+func (i narrowIor[E, A]) IsBoth() bool   { return i.erased.IsBoth() }
+func (i narrowIor[E, A]) Left() Maybe[E] { return i.erased.Left() }
+
+func (i narrowIor[E, A]) Right() Maybe[A] {
+	return KindToMaybe(Kind[MaybeKind, A]{repr: i.erased.Right()})
+}
+
+func (i narrowIor[E, A]) Map(f func(A) any) Ior[E, any] {
+	return i.erased.Map(func(a any) any { return f(cast[A](a)) })
+}
+
+func (i narrowIor[E, A]) FlatMap(f func(A) Ior[E, A]) Ior[E, A] {
+	return narrowIor[E, A]{erased: i.erased.FlatMap(func(a any) Ior[E, any] {
+		return IorToKind(f(cast[A](a))).repr.(Ior[E, any])
+	})}
+}
+
+func (i narrowIor[E, A]) Warn(warning E) Ior[E, A] {
+	return narrowIor[E, A]{erased: i.erased.Warn(warning)}
+}
+
+func (i narrowIor[E, A]) ToValidation() Validation[E, A] {
+	return KindToValidation(Kind[ValidationKind[E], A]{repr: i.erased.ToValidation()})
+}
+
+func (i narrowIor[E, A]) ToResult() Result[A, E] {
+	return narrowResult[A](i.erased.ToResult())
+}
+
+func (i narrowIor[E, A]) ToStrictResult() Result[A, E] {
+	return narrowResult[A](i.erased.ToStrictResult())
+}
+
+// Format formats the erased Ior.
+func (i narrowIor[E, A]) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), i.erased)
+}
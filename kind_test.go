@@ -0,0 +1,108 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKindRoundTrips(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+	errTest := errors.New("test")
+
+	is.Equal(Some(1), KindToMaybe(MaybeToKind(Some(1))))
+	is.Equal(None[int](), KindToMaybe(MaybeToKind(None[int]())))
+	is.Equal(Succeed[int, error](1), KindToResult(ResultToKind(Succeed[int, error](1))))
+	is.Equal(Fail[int](errTest), KindToResult(ResultToKind(Fail[int](errTest))))
+	is.Equal(NewLVal(1), KindToEither(EitherToKind(NewLVal(1))))
+	is.Equal(NewRVal(1), KindToEither(EitherToKind(NewRVal(1))))
+	is.Equal([]int{1, 2}, KindToList(ListToKind(NewList([]int{1, 2}))).Values())
+	is.Equal(NewIdentity(1), KindToIdentity(IdentityToKind(NewIdentity(1))))
+	is.Equal(2, KindToReader(ReaderToKind(NewReader(func(x int) int { return x * 2 }))).Run(1))
+	is.Equal(NewValid[string](1), KindToValidation(ValidationToKind(NewValid[string](1))))
+	is.Equal(NewNonEmptyList("e"), KindToValidation(ValidationToKind(NewInvalid[string, int]("e"))).NonEmptyErrors().Value())
+	is.Equal(Succeed[int, error](1), KindToIO(IOToKind(ResultToIO(Succeed[int, error](1)))).Perform())
+	is.Equal(Succeed[int, error](1), KindToFuture(FutureToKind(ResultToFuture(Succeed[int, error](1)))).Await())
+	is.Equal(
+		Succeed[int, error](1),
+		KindToContinuation(ContinuationToKind(ResultToContinuation(Succeed[int, error](1)))).Run(context.Background()),
+	)
+
+	v, s := KindToState(StateToKind(NewState(func(s int) (string, int) { return "v", s + 1 }))).Run(1)
+	is.Equal("v", v)
+	is.Equal(2, s)
+
+	w := KindToWriter(WriterToKind(NewWriterWith(1, 2, MonoidProduct[int]()))).FlatMap(func(x int) Writer[int, int] {
+		return NewWriter(x, 3)
+	})
+	is.Equal(6, w.Output(), "the Writer keeps combining outputs with its own function")
+}
+
+// otherIor is an Ior of another implementation than the one of this package.
+type otherIor[E, T any] struct {
+	Ior[E, T]
+}
+
+func (i otherIor[E, T]) Map(f func(T) any) Ior[E, any] {
+	return otherIor[E, any]{Ior: i.Ior.Map(f)}
+}
+
+// otherWriter is a Writer of another implementation than the one of this
+// package.
+type otherWriter[W, T any] struct {
+	Writer[W, T]
+}
+
+func (w otherWriter[W, T]) Map(f func(T) any) Writer[W, any] {
+	return otherWriter[W, any]{Writer: w.Writer.Map(f)}
+}
+
+func TestKindToWriterKeepsOtherImplementations(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	w := KindToWriter(WriterToKind[string, int](otherWriter[string, int]{Writer: NewWriterWith(1, "a", MonoidString())}))
+	w = w.FlatMap(func(x int) Writer[string, int] { return NewWriter(x+1, "b") })
+
+	value, output := w.Run()
+	is.Equal(2, value)
+	is.Equal("ab", output)
+	is.Equal("Writer(2, ab)", fmt.Sprint(w))
+}
+
+func TestKindToIorKeepsOtherImplementations(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	i := KindToIor(IorToKind[string, int](otherIor[string, int]{Ior: NewIorBoth(MonoidString(), "a", 1)}))
+	i = i.FlatMap(func(x int) Ior[string, int] { return NewIorBoth(MonoidString(), "b", x+1) }).Warn("c")
+
+	is.Equal(Some("abc"), i.Left())
+	is.Equal(Some(2), i.Right())
+	is.Equal(Succeed[int, string](2), i.ToResult())
+	is.Equal(Fail[int]("abc"), i.ToStrictResult())
+	is.Equal("Both(abc, 2)", fmt.Sprint(i))
+}
+
+func TestKindErasesNilInterfaces(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	k := Pure[MaybeKind, error](MaybeMonad(), nil)
+	is.Equal(Some[error](nil), KindToMaybe(k))
+}
+
+func TestMapKindAndFlatMapKind(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	m := ResultMonad[error]()
+	k := FlatMapKind(m, Pure(m, 2), func(x int) Kind[ResultKind[error], string] {
+		return MapKind(m, Pure(m, x*3), func(y int) string { return string(rune('a' + y)) })
+	})
+	is.Equal(Succeed[string, error]("g"), KindToResult(k))
+}
@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // List represents a generic interface for the List monad. It is a container
 // that holds a slice of type []T and provides monadic methods to perform
 // transformations on the contained elements.
@@ -13,6 +15,9 @@ type List[T any] interface {
 	// FlatMap applies a transformation that returns a new List and concatenates
 	// all resulting Lists into a single List.
 	FlatMap(func(T) List[T]) List[T]
+
+	// Filter returns a List of the elements for which the predicate holds.
+	Filter(Predicate[T]) List[T]
 }
 
 // list is a concrete implementation of the List interface.
@@ -47,3 +52,37 @@ func (l list[T]) FlatMap(f func(T) List[T]) List[T] {
 	}
 	return NewList[T](newValues)
 }
+
+// Filter keeps the elements of the list for which the predicate holds.
+func (l list[T]) Filter(p Predicate[T]) List[T] {
+	newValues := []T{}
+	for _, v := range l.values {
+		if p(v) {
+			newValues = append(newValues, v)
+		}
+	}
+	return NewList(newValues)
+}
+
+// String formats the list as List(values...).
+func (l list[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// GoString formats the list as a call to NewList.
+func (l list[T]) GoString() string {
+	return fmt.Sprintf("%#v", l)
+}
+
+// Format implements fmt.Formatter, applying the verb to each of the values.
+func (l list[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		formatCase(f, verb, "", generic("NewList", typeName[T]()), l.values)
+		return
+	}
+	values := make([]any, len(l.values))
+	for i, v := range l.values {
+		values[i] = v
+	}
+	formatCase(f, verb, "List", "", values...)
+}
@@ -0,0 +1,41 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// NewLogWriter constructs a Writer holding value and collecting the given slog
+// records. FlatMap appends the records of the new Writer to the ones collected
+// so far. Records are only collected: nothing is logged until the Writer is
+// replayed into a slog.Handler with ReplayLogs.
+func NewLogWriter[T any](value T, records ...slog.Record) Writer[[]slog.Record, T] {
+	return NewWriterWith(value, records, MonoidSlice[slog.Record]())
+}
+
+// Log constructs a Writer holding value and a single record built from level,
+// msg and args, with args interpreted as in slog.Logger.Log.
+func Log[T any](value T, level slog.Level, msg string, args ...any) Writer[[]slog.Record, T] {
+	r := slog.NewRecord(time.Now(), level, msg, 0)
+	r.Add(args...)
+	return NewLogWriter(value, r)
+}
+
+// ReplayLogs runs w and hands each of its records enabled by h to h, in the
+// order they were collected. It returns the value of the Writer along with the
+// errors returned by h, joined with errors.Join.
+func ReplayLogs[T any](ctx context.Context, w Writer[[]slog.Record, T], h slog.Handler) (T, error) {
+	value, records := w.Run()
+	var errs []error
+	for _, r := range records {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, r.Clone()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return value, errors.Join(errs...)
+}
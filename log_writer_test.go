@@ -0,0 +1,127 @@
+package monad
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLogger returns a logger writing text records without timestamps into
+// the returned buffer.
+func newTestLogger(level slog.Level) (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	h := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	return slog.New(h), &buf
+}
+
+func TestLogValuer(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"Ok", Succeed[int, error](3), "res.ok=true res.value=3"},
+		{"Err", Fail[int](errors.New("boom")), "res.ok=false res.err=boom"},
+		{"Some", Some(3), "res.present=true res.value=3"},
+		{"None", None[int](), "res.present=false"},
+		{"Left", NewLVal("a"), "res.side=left res.value=a"},
+		{"Right", NewRVal("a"), "res.side=right res.value=a"},
+		{"Valid", NewValid[string](3), "res.valid=true res.value=3"},
+		{"Invalid", NewInvalid[string, int]("a", "b"), `res.valid=false res.errors="[a b]"`},
+		{"Ior", NewIorBoth(MonoidString(), "w", 1), "res.side=both res.left=w res.right=1"},
+		{"Nested", Some(Succeed[int, error](1)), "res.present=true res.value.ok=true res.value.value=1"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			logger, buf := newTestLogger(slog.LevelInfo)
+			logger.Info("msg", "res", tc.value)
+			require.Equal(t, "level=INFO msg=msg "+tc.want+"\n", buf.String())
+		})
+	}
+}
+
+func TestLogWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FlatMap collects records in order", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		w := Log(1, slog.LevelInfo, "start").
+			FlatMap(func(x int) Writer[[]slog.Record, int] {
+				return Log(x+1, slog.LevelDebug, "increment", "x", x)
+			}).
+			FlatMap(func(x int) Writer[[]slog.Record, int] {
+				return Log(x*10, slog.LevelWarn, "scale", "x", x)
+			})
+
+		value, records := w.Run()
+		is.Equal(20, value)
+		is.Len(records, 3)
+		is.Equal("start", records[0].Message)
+		is.Equal("increment", records[1].Message)
+		is.Equal("scale", records[2].Message)
+	})
+
+	t.Run("Map keeps records", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		w := Log(1, slog.LevelInfo, "start").Map(func(x int) any { return x + 1 })
+		is.Equal(2, w.Value())
+		is.Len(w.Output(), 1)
+	})
+
+	t.Run("ReplayLogs emits enabled records", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		w := Log(1, slog.LevelDebug, "hidden").
+			FlatMap(func(x int) Writer[[]slog.Record, int] {
+				return Log(x+1, slog.LevelInfo, "shown", "x", x)
+			})
+
+		logger, buf := newTestLogger(slog.LevelInfo)
+		value, err := ReplayLogs(context.Background(), w, logger.Handler())
+		is.NoError(err)
+		is.Equal(2, value)
+		is.Equal("level=INFO msg=shown x=1\n", buf.String())
+	})
+
+	t.Run("ReplayLogs reports handler errors", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		w := NewLogWriter(1, slog.NewRecord(time.Time{}, slog.LevelInfo, "a", 0))
+		_, err := ReplayLogs(context.Background(), w, failingHandler{})
+		is.ErrorIs(err, errHandler)
+	})
+}
+
+var errHandler = errors.New("handler failed")
+
+// failingHandler is a slog.Handler that fails to handle any record.
+type failingHandler struct{}
+
+func (failingHandler) Enabled(context.Context, slog.Level) bool  { return true }
+func (failingHandler) Handle(context.Context, slog.Record) error { return errHandler }
+func (h failingHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h failingHandler) WithGroup(string) slog.Handler           { return h }
@@ -1,14 +1,25 @@
 package monad
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Maybe is a Monad that allows a value to be either just or Nothing
 type Maybe[T any] interface {
 	Just() bool
 	Nothing() bool
 	Value() T
 	OrElse(T) T
+	OrElseGet(func() T) T
+	Or(Maybe[T]) Maybe[T]
 	Filter(p Predicate[T]) Maybe[T]
 	Map(func(T) Maybe[any]) Maybe[any]
 	FlatMap(func(T) Maybe[T]) Maybe[T]
+	Contains(x T, eq func(T, T) bool) bool
+	IfPresent(func(T))
+	ToPointer() *T
+	ToSlice() []T
 }
 
 // just represents a Maybe monad with a just value
@@ -36,6 +47,16 @@ func (j just[T]) OrElse(_ T) T {
 	return j.val
 }
 
+// OrElseGet gives the underlying value without calling the callback
+func (j just[T]) OrElseGet(_ func() T) T {
+	return j.val
+}
+
+// Or returns itself
+func (j just[T]) Or(_ Maybe[T]) Maybe[T] {
+	return j
+}
+
 // Filter returns the just value if the predicate is true, nothing elseway
 func (j just[T]) Filter(p Predicate[T]) Maybe[T] {
 	if p(j.val) {
@@ -54,6 +75,48 @@ func (j just[T]) FlatMap(f func(T) Maybe[T]) Maybe[T] {
 	return f(j.val)
 }
 
+// Contains reports whether the underlying value is equal to x according to eq
+func (j just[T]) Contains(x T, eq func(T, T) bool) bool {
+	return eq(j.val, x)
+}
+
+// IfPresent calls the callback with the underlying value
+func (j just[T]) IfPresent(f func(T)) {
+	f(j.val)
+}
+
+// ToPointer returns a pointer to a copy of the underlying value
+func (j just[T]) ToPointer() *T {
+	v := j.val
+	return &v
+}
+
+// ToSlice returns a slice holding the underlying value
+func (j just[T]) ToSlice() []T {
+	return []T{j.val}
+}
+
+// String formats the Maybe as Some(value)
+func (j just[T]) String() string {
+	return fmt.Sprint(j)
+}
+
+// GoString formats the Maybe as a call to Some
+func (j just[T]) GoString() string {
+	return fmt.Sprintf("%#v", j)
+}
+
+// Format implements fmt.Formatter, applying the verb to the underlying value
+func (j just[T]) Format(f fmt.State, verb rune) {
+	formatCase(f, verb, "Some", generic("Some", typeName[T]()), j.val)
+}
+
+// LogValue implements slog.LogValuer, logging the Maybe as a group holding
+// present=true and the value
+func (j just[T]) LogValue() slog.Value {
+	return slog.GroupValue(slog.Bool("present", true), slog.Any("value", j.val))
+}
+
 // nothing represents an empty Maybe of type T
 type nothing[T any] struct{}
 
@@ -77,6 +140,16 @@ func (n nothing[T]) OrElse(x T) T {
 	return x
 }
 
+// OrElseGet returns the result of the callback
+func (n nothing[T]) OrElseGet(f func() T) T {
+	return f()
+}
+
+// Or returns the alternative Maybe
+func (n nothing[T]) Or(m Maybe[T]) Maybe[T] {
+	return m
+}
+
 // Filter returns nothing
 func (n nothing[T]) Filter(_ Predicate[T]) Maybe[T] {
 	return n
@@ -92,6 +165,49 @@ func (n nothing[T]) FlatMap(_ func(T) Maybe[T]) Maybe[T] {
 	return n
 }
 
+// Contains is always false
+func (n nothing[T]) Contains(_ T, _ func(T, T) bool) bool {
+	return false
+}
+
+// IfPresent does nothing
+func (n nothing[T]) IfPresent(_ func(T)) {}
+
+// ToPointer returns nil
+func (n nothing[T]) ToPointer() *T {
+	return nil
+}
+
+// ToSlice returns an empty slice
+func (n nothing[T]) ToSlice() []T {
+	return []T{}
+}
+
+// String formats the Maybe as None
+func (n nothing[T]) String() string {
+	return fmt.Sprint(n)
+}
+
+// GoString formats the Maybe as a call to None
+func (n nothing[T]) GoString() string {
+	return fmt.Sprintf("%#v", n)
+}
+
+// Format implements fmt.Formatter
+func (n nothing[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		formatCase(f, verb, "", generic("None", typeName[T]()))
+		return
+	}
+	_, _ = fmt.Fprint(f, "None")
+}
+
+// LogValue implements slog.LogValuer, logging the Maybe as a group holding
+// present=false
+func (n nothing[T]) LogValue() slog.Value {
+	return slog.GroupValue(slog.Bool("present", false))
+}
+
 // Some creates a just Maybe from a value
 func Some[T any](x T) Maybe[T] {
 	return just[T]{val: x}
@@ -109,3 +225,67 @@ func Nullable[T any](x *T) Maybe[T] {
 	}
 	return Some[T](*x)
 }
+
+// FromOk creates a Maybe from the comma-ok idiom, nothing if ok is false, just
+// elseway
+func FromOk[T any](x T, ok bool) Maybe[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(x)
+}
+
+// ToResult converts a Maybe into a Result, using err as the failure when the
+// Maybe is nothing
+func ToResult[T, E any](m Maybe[T], err E) Result[T, E] {
+	if m.Nothing() {
+		return Fail[T, E](err)
+	}
+	return Succeed[T, E](m.Value())
+}
+
+// Lookup returns the value stored under k in m, nothing if there is none
+func Lookup[K comparable, V any](m map[K]V, k K) Maybe[V] {
+	v, ok := m[k]
+	return FromOk(v, ok)
+}
+
+// Index returns the element at index i of s, nothing if i is out of range
+func Index[T any](s []T, i int) Maybe[T] {
+	if i < 0 || i >= len(s) {
+		return None[T]()
+	}
+	return Some(s[i])
+}
+
+// FirstSome returns the first just Maybe of its arguments, nothing if there is
+// none
+func FirstSome[T any](ms ...Maybe[T]) Maybe[T] {
+	for _, m := range ms {
+		if m.Just() {
+			return m
+		}
+	}
+	return None[T]()
+}
+
+// CatMaybes returns the values of the just Maybes of ms, discarding the
+// nothings
+func CatMaybes[T any](ms []Maybe[T]) []T {
+	values := make([]T, 0, len(ms))
+	for _, m := range ms {
+		if m.Just() {
+			values = append(values, m.Value())
+		}
+	}
+	return values
+}
+
+// Zip combines two Maybes into a Maybe of their pair, nothing if either is
+// nothing
+func Zip[A, B any](a Maybe[A], b Maybe[B]) Maybe[Pair[A, B]] {
+	if a.Nothing() || b.Nothing() {
+		return None[Pair[A, B]]()
+	}
+	return Some(NewPair(a.Value(), b.Value()))
+}
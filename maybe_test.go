@@ -1,6 +1,7 @@
 package monad
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -101,3 +102,78 @@ func TestMaybeMethods(t *testing.T) {
 	is.Equal(5, just.Value())
 	is.Equal(0, nothing.Value()) // should be zero value for type int
 }
+
+func TestMaybeAlternatives(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	calls := 0
+	get := func() int { calls++; return 10 }
+
+	is.Equal(5, Some(5).OrElseGet(get))
+	is.Equal(0, calls)
+	is.Equal(10, None[int]().OrElseGet(get))
+	is.Equal(1, calls)
+
+	is.Equal(Some(5), Some(5).Or(Some(6)))
+	is.Equal(Some(6), None[int]().Or(Some(6)))
+	is.True(None[int]().Or(None[int]()).Nothing())
+
+	is.Equal(Some(2), FirstSome(None[int](), Some(2), Some(3)))
+	is.True(FirstSome[int]().Nothing())
+}
+
+func TestMaybeConversions(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	p := Some(5).ToPointer()
+	is.NotNil(p)
+	is.Equal(5, *p)
+	is.Nil(None[int]().ToPointer())
+
+	is.Equal([]int{5}, Some(5).ToSlice())
+	is.Empty(None[int]().ToSlice())
+
+	err := errors.New("missing")
+	is.Equal(Succeed[int, error](5), ToResult(Some(5), err))
+	is.Equal(Fail[int](err), ToResult(None[int](), err))
+
+	is.Equal(Some(1), FromOk(1, true))
+	is.True(FromOk(1, false).Nothing())
+
+	is.Equal([]int{1, 3}, CatMaybes([]Maybe[int]{Some(1), None[int](), Some(3)}))
+}
+
+func TestMaybeLookups(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	m := map[string]int{"a": 1}
+	is.Equal(Some(1), Lookup(m, "a"))
+	is.True(Lookup(m, "b").Nothing())
+
+	s := []string{"x", "y"}
+	is.Equal(Some("y"), Index(s, 1))
+	is.True(Index(s, 2).Nothing())
+	is.True(Index(s, -1).Nothing())
+}
+
+func TestMaybeInspection(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	eq := func(a, b int) bool { return a == b }
+	is.True(Some(5).Contains(5, eq))
+	is.False(Some(5).Contains(6, eq))
+	is.False(None[int]().Contains(0, eq))
+
+	var seen []int
+	Some(5).IfPresent(func(x int) { seen = append(seen, x) })
+	None[int]().IfPresent(func(x int) { seen = append(seen, x) })
+	is.Equal([]int{5}, seen)
+
+	is.Equal(Some(NewPair(1, "a")), Zip(Some(1), Some("a")))
+	is.True(Zip(None[int](), Some("a")).Nothing())
+	is.True(Zip(Some(1), None[string]()).Nothing())
+}
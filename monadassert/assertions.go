@@ -0,0 +1,171 @@
+// Package monadassert provides testify-style assertions for the values of the
+// monad package.
+//
+// Every assertion reports a descriptive message through t.Errorf when it
+// fails, including a diff when a held value differs from the expected one, and
+// returns whether it succeeded so that further assertions can be skipped. The
+// monadrequire package provides the same assertions, stopping the test when
+// they fail.
+package monadassert
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/denisdubochevalier/monad"
+)
+
+// tHelper is implemented by *testing.T, allowing assertions to be skipped
+// when reporting the line of a failure.
+type tHelper interface {
+	Helper()
+}
+
+// Success asserts that r is a success.
+func Success[T, E any](t assert.TestingT, r monad.Result[T, E], msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Failure() {
+		return assert.Fail(t, fmt.Sprintf("Expected a success, got %v", r), msgAndArgs...)
+	}
+	return true
+}
+
+// SuccessWith asserts that r is a success holding want.
+func SuccessWith[T, E any](t assert.TestingT, r monad.Result[T, E], want T, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Failure() {
+		return assert.Fail(t, fmt.Sprintf("Expected Ok(%v), got %v", want, r), msgAndArgs...)
+	}
+	return assert.Equal(t, want, r.Value(), msgAndArgs...)
+}
+
+// Failure asserts that r is a failure.
+func Failure[T, E any](t assert.TestingT, r monad.Result[T, E], msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Success() {
+		return assert.Fail(t, fmt.Sprintf("Expected a failure, got %v", r), msgAndArgs...)
+	}
+	return true
+}
+
+// FailureIs asserts that r is a failure whose error matches target according
+// to errors.Is.
+func FailureIs[T any](t assert.TestingT, r monad.Result[T, error], target error, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if r.Success() {
+		return assert.Fail(t, fmt.Sprintf("Expected a failure matching %q, got %v", target, r), msgAndArgs...)
+	}
+	if !errors.Is(r.Error(), target) {
+		return assert.Fail(t, fmt.Sprintf(
+			"Error chain does not match the target\nexpected: %q\nin chain: %s",
+			target, monad.Trace(r.Error()),
+		), msgAndArgs...)
+	}
+	return true
+}
+
+// Just asserts that m is a just value holding want.
+func Just[T any](t assert.TestingT, m monad.Maybe[T], want T, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if m.Nothing() {
+		return assert.Fail(t, fmt.Sprintf("Expected Some(%v), got None", want), msgAndArgs...)
+	}
+	return assert.Equal(t, want, m.Value(), msgAndArgs...)
+}
+
+// Nothing asserts that m is nothing.
+func Nothing[T any](t assert.TestingT, m monad.Maybe[T], msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if m.Just() {
+		return assert.Fail(t, fmt.Sprintf("Expected None, got %v", m), msgAndArgs...)
+	}
+	return true
+}
+
+// Valid asserts that v is valid.
+func Valid[E, T any](t assert.TestingT, v monad.Validation[E, T], msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !v.Valid() {
+		return assert.Fail(t, fmt.Sprintf("Expected a valid value, got %v", v), msgAndArgs...)
+	}
+	return true
+}
+
+// InvalidWith asserts that v is invalid and holds exactly the errors errs, in
+// the same order.
+func InvalidWith[E, T any](t assert.TestingT, v monad.Validation[E, T], errs []E, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if v.Valid() {
+		return assert.Fail(t, fmt.Sprintf("Expected Invalid%v, got %v", errs, v), msgAndArgs...)
+	}
+	return assert.Equal(t, errs, v.Errors(), msgAndArgs...)
+}
+
+// Right asserts that e is a right value holding want.
+func Right[T any](t assert.TestingT, e monad.Either[T], want T, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if e.Left() {
+		return assert.Fail(t, fmt.Sprintf("Expected Right(%v), got %v", want, e), msgAndArgs...)
+	}
+	return assert.Equal(t, want, e.Value(), msgAndArgs...)
+}
+
+// Left asserts that e is a left value holding want.
+func Left[T any](t assert.TestingT, e monad.Either[T], want T, msgAndArgs ...any) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if e.Right() {
+		return assert.Fail(t, fmt.Sprintf("Expected Left(%v), got %v", want, e), msgAndArgs...)
+	}
+	return assert.Equal(t, want, e.Value(), msgAndArgs...)
+}
+
+// FutureResolvesWithin asserts that f resolves within d. The Future is awaited
+// in its own goroutine, which keeps running in the background if it does not
+// resolve in time. A panic raised while awaiting it fails the assertion.
+func FutureResolvesWithin[T, E any](
+	t assert.TestingT, f monad.Future[T, E], d time.Duration, msgAndArgs ...any,
+) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	done := make(chan any, 1)
+	go func() {
+		defer func() { done <- recover() }()
+		f.Await()
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case p := <-done:
+		if p != nil {
+			return assert.Fail(t, fmt.Sprintf("Expected the Future to resolve, it panicked: %v", p), msgAndArgs...)
+		}
+		return true
+	case <-timer.C:
+		return assert.Fail(t, fmt.Sprintf("Expected the Future to resolve within %s", d), msgAndArgs...)
+	}
+}
@@ -0,0 +1,176 @@
+package monadassert_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/denisdubochevalier/monad"
+	"github.com/denisdubochevalier/monad/monadassert"
+)
+
+// recordingT is an assert.TestingT recording the reported errors.
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestResultAssertions(t *testing.T) {
+	t.Parallel()
+
+	errTest := fmt.Errorf("reading: %w", fs.ErrNotExist)
+	cases := []struct {
+		name    string
+		assert  func(t *recordingT) bool
+		message string
+	}{
+		{"Success passes", func(t *recordingT) bool {
+			return monadassert.Success(t, monad.Succeed[int, error](1))
+		}, ""},
+		{"Success fails", func(t *recordingT) bool {
+			return monadassert.Success(t, monad.Fail[int](errTest))
+		}, "Expected a success, got Err(reading: file does not exist)"},
+		{"SuccessWith passes", func(t *recordingT) bool {
+			return monadassert.SuccessWith(t, monad.Succeed[int, error](1), 1)
+		}, ""},
+		{"SuccessWith fails on the value", func(t *recordingT) bool {
+			return monadassert.SuccessWith(t, monad.Succeed[int, error](1), 2)
+		}, "Not equal"},
+		{"SuccessWith fails on a failure", func(t *recordingT) bool {
+			return monadassert.SuccessWith(t, monad.Fail[int](errTest), 2)
+		}, "Expected Ok(2), got Err("},
+		{"Failure passes", func(t *recordingT) bool {
+			return monadassert.Failure(t, monad.Fail[int](errTest))
+		}, ""},
+		{"Failure fails", func(t *recordingT) bool {
+			return monadassert.Failure(t, monad.Succeed[int, error](1))
+		}, "Expected a failure, got Ok(1)"},
+		{"FailureIs passes", func(t *recordingT) bool {
+			return monadassert.FailureIs(t, monad.Fail[int](errTest), fs.ErrNotExist)
+		}, ""},
+		{"FailureIs fails on another error", func(t *recordingT) bool {
+			return monadassert.FailureIs(t, monad.Fail[int](errTest), fs.ErrClosed)
+		}, "Error chain does not match the target"},
+		{"FailureIs fails on a success", func(t *recordingT) bool {
+			return monadassert.FailureIs(t, monad.Succeed[int, error](1), fs.ErrClosed)
+		}, "got Ok(1)"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assertOutcome(t, tc.assert, tc.message)
+		})
+	}
+}
+
+func TestMaybeEitherValidationAssertions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		assert  func(t *recordingT) bool
+		message string
+	}{
+		{"Just passes", func(t *recordingT) bool { return monadassert.Just(t, monad.Some(1), 1) }, ""},
+		{"Just fails on nothing", func(t *recordingT) bool {
+			return monadassert.Just(t, monad.None[int](), 1)
+		}, "Expected Some(1), got None"},
+		{"Just fails on the value", func(t *recordingT) bool {
+			return monadassert.Just(t, monad.Some([]int{1}), []int{2})
+		}, "Diff:"},
+		{"Nothing passes", func(t *recordingT) bool { return monadassert.Nothing(t, monad.None[int]()) }, ""},
+		{"Nothing fails", func(t *recordingT) bool {
+			return monadassert.Nothing(t, monad.Some(1))
+		}, "Expected None, got Some(1)"},
+		{"Right passes", func(t *recordingT) bool { return monadassert.Right(t, monad.NewRVal(1), 1) }, ""},
+		{"Right fails", func(t *recordingT) bool {
+			return monadassert.Right(t, monad.NewLVal(1), 1)
+		}, "Expected Right(1), got Left(1)"},
+		{"Left passes", func(t *recordingT) bool { return monadassert.Left(t, monad.NewLVal(1), 1) }, ""},
+		{"Left fails", func(t *recordingT) bool {
+			return monadassert.Left(t, monad.NewRVal(1), 1)
+		}, "Expected Left(1), got Right(1)"},
+		{"Valid passes", func(t *recordingT) bool { return monadassert.Valid(t, monad.NewValid[string](1)) }, ""},
+		{"Valid fails", func(t *recordingT) bool {
+			return monadassert.Valid(t, monad.NewInvalid[string, int]("a"))
+		}, "Expected a valid value, got Invalid(a)"},
+		{"InvalidWith passes", func(t *recordingT) bool {
+			return monadassert.InvalidWith(t, monad.NewInvalid[string, int]("a", "b"), []string{"a", "b"})
+		}, ""},
+		{"InvalidWith fails on the errors", func(t *recordingT) bool {
+			return monadassert.InvalidWith(t, monad.NewInvalid[string, int]("a"), []string{"b"}, "errs of %s", "v")
+		}, "errs of v"},
+		{"InvalidWith fails on a valid value", func(t *recordingT) bool {
+			return monadassert.InvalidWith(t, monad.NewValid[string](1), []string{"a"})
+		}, "Expected Invalid[a], got Valid(1)"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assertOutcome(t, tc.assert, tc.message)
+		})
+	}
+}
+
+func TestFutureResolvesWithin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Resolved in time", func(t *testing.T) {
+		t.Parallel()
+		f := monad.NewFuture(func() monad.Result[int, error] { return monad.Succeed[int, error](1) })
+		assertOutcome(t, func(rt *recordingT) bool {
+			return monadassert.FutureResolvesWithin(rt, f, time.Second)
+		}, "")
+	})
+
+	t.Run("Not resolved in time", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		defer close(release)
+		f := monad.NewFuture(func() monad.Result[int, error] {
+			<-release
+			return monad.Fail[int](errors.New("late"))
+		})
+		assertOutcome(t, func(rt *recordingT) bool {
+			return monadassert.FutureResolvesWithin(rt, f, 10*time.Millisecond)
+		}, "Expected the Future to resolve within 10ms")
+	})
+
+	t.Run("Panicked", func(t *testing.T) {
+		t.Parallel()
+		f := monad.NewFuture(func() monad.Result[int, error] { panic("boom") })
+		assertOutcome(t, func(rt *recordingT) bool {
+			return monadassert.FutureResolvesWithin(rt, f, time.Second)
+		}, "Expected the Future to resolve, it panicked: panic: boom")
+	})
+}
+
+// assertOutcome runs assert against a recordingT, checking that it passes when
+// message is empty, and that it fails with a message containing message
+// otherwise.
+func assertOutcome(t *testing.T, assert func(*recordingT) bool, message string) {
+	t.Helper()
+	is := require.New(t)
+
+	rt := &recordingT{}
+	ok := assert(rt)
+	if message == "" {
+		is.True(ok)
+		is.Empty(rt.errors)
+		return
+	}
+	is.False(ok)
+	is.Len(rt.errors, 1)
+	is.Contains(rt.errors[0], message)
+}
@@ -0,0 +1,133 @@
+// Package monadrequire provides the assertions of the monadassert package,
+// stopping the test with t.FailNow when they fail instead of letting it
+// continue.
+package monadrequire
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/denisdubochevalier/monad"
+	"github.com/denisdubochevalier/monad/monadassert"
+)
+
+// tHelper is implemented by *testing.T, allowing assertions to be skipped
+// when reporting the line of a failure.
+type tHelper interface {
+	Helper()
+}
+
+// Success requires that r is a success.
+func Success[T, E any](t require.TestingT, r monad.Result[T, E], msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Success(t, r, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// SuccessWith requires that r is a success holding want.
+func SuccessWith[T, E any](t require.TestingT, r monad.Result[T, E], want T, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.SuccessWith(t, r, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Failure requires that r is a failure.
+func Failure[T, E any](t require.TestingT, r monad.Result[T, E], msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Failure(t, r, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FailureIs requires that r is a failure whose error matches target according
+// to errors.Is.
+func FailureIs[T any](t require.TestingT, r monad.Result[T, error], target error, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.FailureIs(t, r, target, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Just requires that m is a just value holding want.
+func Just[T any](t require.TestingT, m monad.Maybe[T], want T, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Just(t, m, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Nothing requires that m is nothing.
+func Nothing[T any](t require.TestingT, m monad.Maybe[T], msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Nothing(t, m, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Valid requires that v is valid.
+func Valid[E, T any](t require.TestingT, v monad.Validation[E, T], msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Valid(t, v, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InvalidWith requires that v is invalid and holds exactly the errors errs, in
+// the same order.
+func InvalidWith[E, T any](t require.TestingT, v monad.Validation[E, T], errs []E, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.InvalidWith(t, v, errs, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Right requires that e is a right value holding want.
+func Right[T any](t require.TestingT, e monad.Either[T], want T, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Right(t, e, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Left requires that e is a left value holding want.
+func Left[T any](t require.TestingT, e monad.Either[T], want T, msgAndArgs ...any) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.Left(t, e, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// FutureResolvesWithin requires that f resolves within d.
+func FutureResolvesWithin[T, E any](
+	t require.TestingT, f monad.Future[T, E], d time.Duration, msgAndArgs ...any,
+) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if !monadassert.FutureResolvesWithin(t, f, d, msgAndArgs...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,77 @@
+package monadrequire_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/denisdubochevalier/monad"
+	"github.com/denisdubochevalier/monad/monadrequire"
+)
+
+// recordingT is a require.TestingT recording whether the test was stopped.
+type recordingT struct {
+	errors  []string
+	stopped bool
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) FailNow() {
+	r.stopped = true
+}
+
+func TestRequirementsStopTheTestOnFailure(t *testing.T) {
+	t.Parallel()
+
+	failing := map[string]func(*recordingT){
+		"Success":     func(t *recordingT) { monadrequire.Success(t, monad.Fail[int]("ko")) },
+		"SuccessWith": func(t *recordingT) { monadrequire.SuccessWith(t, monad.Succeed[int, string](1), 2) },
+		"Failure":     func(t *recordingT) { monadrequire.Failure(t, monad.Succeed[int, string](1)) },
+		"FailureIs": func(t *recordingT) {
+			monadrequire.FailureIs(t, monad.Succeed[int, error](1), fmt.Errorf("ko"))
+		},
+		"Just":        func(t *recordingT) { monadrequire.Just(t, monad.None[int](), 1) },
+		"Nothing":     func(t *recordingT) { monadrequire.Nothing(t, monad.Some(1)) },
+		"Valid":       func(t *recordingT) { monadrequire.Valid(t, monad.NewInvalid[string, int]("a")) },
+		"InvalidWith": func(t *recordingT) { monadrequire.InvalidWith(t, monad.NewValid[string](1), []string{"a"}) },
+		"Right":       func(t *recordingT) { monadrequire.Right(t, monad.NewLVal(1), 1) },
+		"Left":        func(t *recordingT) { monadrequire.Left(t, monad.NewRVal(1), 1) },
+		"FutureResolvesWithin": func(t *recordingT) {
+			f := monad.NewFuture(func() monad.Result[int, error] {
+				time.Sleep(time.Second)
+				return monad.Succeed[int, error](1)
+			})
+			monadrequire.FutureResolvesWithin(t, f, time.Millisecond)
+		},
+	}
+
+	for name, run := range failing {
+		name, run := name, run
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			is := require.New(t)
+
+			rt := &recordingT{}
+			run(rt)
+			is.True(rt.stopped)
+			is.Len(rt.errors, 1)
+		})
+	}
+}
+
+func TestRequirementsLetTheTestContinueOnSuccess(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	rt := &recordingT{}
+	monadrequire.SuccessWith(rt, monad.Succeed[int, string](1), 1)
+	monadrequire.Just(rt, monad.Some(1), 1)
+	monadrequire.InvalidWith(rt, monad.NewInvalid[string, int]("a"), []string{"a"})
+	is.False(rt.stopped)
+	is.Empty(rt.errors)
+}
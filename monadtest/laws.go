@@ -0,0 +1,191 @@
+// Package monadtest verifies that monads obey the functor and monad laws.
+//
+// The laws are checked with randomized inputs:
+//   - Functor Identity:    fmap id  ==  id
+//   - Functor Composition: fmap (f . g)  ==  fmap f . fmap g
+//   - Left Identity:       return a >>= f  ==  f a
+//   - Right Identity:      m >>= return  ==  m
+//   - Associativity:       (m >>= f) >>= g  ==  m >>= (\x -> f x >>= g)
+//
+// A monad under test is described by a Laws value, which tells how to build,
+// chain, generate and observe its values. Suites for every monad of the monad
+// package are provided, and the same Laws type can describe user-defined
+// monads.
+package monadtest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Laws describes a monad M over values of type A so that its laws can be
+// checked.
+type Laws[A, M any] struct {
+	// Name identifies the monad in reports.
+	Name string
+
+	// Pure lifts a value into the monad.
+	Pure func(A) M
+
+	// FlatMap chains a monadic value with a monadic function.
+	FlatMap func(M, func(A) M) M
+
+	// Map applies a function to the value of the monad. The functor laws are
+	// only checked when Map is set.
+	Map func(M, func(A) A) M
+
+	// Observe turns a monadic value into what is compared when checking a law,
+	// for instance by running a lazy monad. Observations are compared with
+	// reflect.DeepEqual. Observe defaults to the identity.
+	Observe func(M) any
+
+	// GenValue generates a random value.
+	GenValue func(*rand.Rand) A
+
+	// GenMonad generates a random monadic value, which should cover every case
+	// of the monad. It defaults to lifting a random value with Pure.
+	GenMonad func(*rand.Rand) M
+
+	// GenKleisli generates a random monadic function.
+	GenKleisli func(*rand.Rand) func(A) M
+
+	// GenFunc generates a random function. It is required when Map is set.
+	GenFunc func(*rand.Rand) func(A) A
+}
+
+// Config controls how laws are checked.
+type Config struct {
+	// Iterations is the number of random inputs each law is checked with. It
+	// defaults to 100.
+	Iterations int
+
+	// Seed seeds the random generators, making a check reproducible. It
+	// defaults to the current time.
+	Seed int64
+}
+
+// Counterexample describes inputs for which a law does not hold.
+type Counterexample struct {
+	// Monad is the name of the monad under test.
+	Monad string
+
+	// Law is the name of the law that does not hold.
+	Law string
+
+	// Seed and Iteration locate the counterexample: checking the laws again
+	// with the same seed reproduces it at the same iteration.
+	Seed      int64
+	Iteration int
+
+	// Inputs holds the generated values the law was checked with. Generated
+	// functions are not included.
+	Inputs []any
+
+	// Left and Right are the observations of both sides of the law.
+	Left, Right any
+}
+
+// String describes the counterexample.
+func (c Counterexample) String() string {
+	return fmt.Sprintf(
+		"%s: %s does not hold (seed %d, iteration %d)\n\tinputs: %v\n\tleft:   %+v\n\tright:  %+v",
+		c.Monad, c.Law, c.Seed, c.Iteration, c.Inputs, c.Left, c.Right,
+	)
+}
+
+// law is a single law, checked against the inputs drawn from rng. It returns
+// the inputs and the observations of both sides of the law.
+type law struct {
+	name  string
+	check func(rng *rand.Rand) (inputs []any, left, right any)
+}
+
+// Check checks every law of l and returns one counterexample for each law that
+// does not hold, or nothing if they all hold.
+func Check[A, M any](l Laws[A, M], cfg Config) []Counterexample {
+	if cfg.Iterations <= 0 {
+		cfg.Iterations = 100
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	l = l.withDefaults()
+
+	var counterexamples []Counterexample
+	for _, lw := range l.laws() {
+		rng := rand.New(rand.NewSource(cfg.Seed))
+		for i := 0; i < cfg.Iterations; i++ {
+			inputs, left, right := lw.check(rng)
+			if !reflect.DeepEqual(left, right) {
+				counterexamples = append(counterexamples, Counterexample{
+					Monad:     l.Name,
+					Law:       lw.name,
+					Seed:      cfg.Seed,
+					Iteration: i,
+					Inputs:    inputs,
+					Left:      left,
+					Right:     right,
+				})
+				break
+			}
+		}
+	}
+	return counterexamples
+}
+
+// Verify checks every law of l, reporting each counterexample as an error of t.
+func Verify[A, M any](t testing.TB, l Laws[A, M], cfg Config) {
+	t.Helper()
+	for _, c := range Check(l, cfg) {
+		t.Error(c.String())
+	}
+}
+
+// withDefaults returns a copy of l with its optional fields set.
+func (l Laws[A, M]) withDefaults() Laws[A, M] {
+	if l.Observe == nil {
+		l.Observe = func(m M) any { return m }
+	}
+	if l.GenMonad == nil {
+		l.GenMonad = func(rng *rand.Rand) M { return l.Pure(l.GenValue(rng)) }
+	}
+	return l
+}
+
+// laws returns the laws that apply to l.
+func (l Laws[A, M]) laws() []law {
+	laws := []law{
+		{name: "left identity", check: func(rng *rand.Rand) ([]any, any, any) {
+			a, f := l.GenValue(rng), l.GenKleisli(rng)
+			return []any{a}, l.Observe(l.FlatMap(l.Pure(a), f)), l.Observe(f(a))
+		}},
+		{name: "right identity", check: func(rng *rand.Rand) ([]any, any, any) {
+			m := l.GenMonad(rng)
+			return []any{m}, l.Observe(l.FlatMap(m, l.Pure)), l.Observe(m)
+		}},
+		{name: "associativity", check: func(rng *rand.Rand) ([]any, any, any) {
+			m, f, g := l.GenMonad(rng), l.GenKleisli(rng), l.GenKleisli(rng)
+			left := l.FlatMap(l.FlatMap(m, f), g)
+			right := l.FlatMap(m, func(x A) M { return l.FlatMap(f(x), g) })
+			return []any{m}, l.Observe(left), l.Observe(right)
+		}},
+	}
+	if l.Map == nil {
+		return laws
+	}
+	return append([]law{
+		{name: "functor identity", check: func(rng *rand.Rand) ([]any, any, any) {
+			m := l.GenMonad(rng)
+			return []any{m}, l.Observe(l.Map(m, func(x A) A { return x })), l.Observe(m)
+		}},
+		{name: "functor composition", check: func(rng *rand.Rand) ([]any, any, any) {
+			m, f, g := l.GenMonad(rng), l.GenFunc(rng), l.GenFunc(rng)
+			left := l.Map(m, func(x A) A { return f(g(x)) })
+			right := l.Map(l.Map(m, g), f)
+			return []any{m}, l.Observe(left), l.Observe(right)
+		}},
+	}, laws...)
+}
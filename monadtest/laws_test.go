@@ -0,0 +1,91 @@
+package monadtest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/denisdubochevalier/monad"
+	"github.com/denisdubochevalier/monad/monadtest"
+)
+
+func TestBuiltinMonadsObeyTheLaws(t *testing.T) {
+	t.Parallel()
+
+	cfg := monadtest.Config{Iterations: 500}
+	t.Run("Maybe", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.MaybeLaws(), cfg) })
+	t.Run("Result", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.ResultLaws(), cfg) })
+	t.Run("Either", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.EitherLaws(), cfg) })
+	t.Run("List", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.ListLaws(), cfg) })
+	t.Run("Identity", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.IdentityLaws(), cfg) })
+	t.Run("Reader", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.ReaderLaws(), cfg) })
+	t.Run("Writer", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.WriterLaws(), cfg) })
+	t.Run("State", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.StateLaws(), cfg) })
+	t.Run("IO", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.IOLaws(), cfg) })
+	t.Run("Future", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.FutureLaws(), cfg) })
+	t.Run("Eval", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.EvalLaws(), cfg) })
+	t.Run("Ior", func(t *testing.T) { t.Parallel(); monadtest.Verify(t, monadtest.IorLaws(), cfg) })
+	t.Run("Validation", func(t *testing.T) {
+		t.Parallel()
+		monadtest.Verify(t, monadtest.ValidationLaws(), cfg)
+	})
+}
+
+// brokenLaws describes a Writer whose FlatMap drops the output of the original
+// Writer, which breaks the right identity law.
+func brokenLaws() monadtest.Laws[int, monad.Writer[[]string, int]] {
+	laws := monadtest.WriterLaws()
+	laws.Name = "BrokenWriter"
+	laws.FlatMap = func(
+		m monad.Writer[[]string, int], f func(int) monad.Writer[[]string, int],
+	) monad.Writer[[]string, int] {
+		return f(m.Value())
+	}
+	laws.GenMonad = func(rng *rand.Rand) monad.Writer[[]string, int] {
+		return monad.NewWriter(monadtest.GenInt(rng), []string{"log"})
+	}
+	return laws
+}
+
+func TestCheckReportsCounterexamples(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	cfg := monadtest.Config{Iterations: 50, Seed: 42}
+	counterexamples := monadtest.Check(brokenLaws(), cfg)
+
+	laws := make([]string, len(counterexamples))
+	for i, c := range counterexamples {
+		laws[i] = c.Law
+		is.Equal("BrokenWriter", c.Monad)
+		is.Equal(int64(42), c.Seed)
+		is.NotEqual(c.Left, c.Right)
+	}
+	is.Equal([]string{"right identity"}, laws)
+	is.Contains(counterexamples[0].String(), "BrokenWriter: right identity does not hold (seed 42")
+
+	// The same seed reproduces the same counterexamples.
+	again := monadtest.Check(brokenLaws(), cfg)
+	is.Len(again, len(counterexamples))
+	is.Equal(counterexamples[0].String(), again[0].String())
+}
+
+func TestCheckSkipsFunctorLawsWithoutMap(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	laws := brokenLaws()
+	laws.Map = nil
+	laws.FlatMap = func(
+		m monad.Writer[[]string, int], f func(int) monad.Writer[[]string, int],
+	) monad.Writer[[]string, int] {
+		return monad.NewWriter(0, []string(nil))
+	}
+
+	counterexamples := monadtest.Check(laws, monadtest.Config{Seed: 1})
+	for _, c := range counterexamples {
+		is.NotContains(c.Law, "functor")
+	}
+	is.NotEmpty(counterexamples)
+}
@@ -0,0 +1,546 @@
+package monadtest
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+
+	"github.com/denisdubochevalier/monad"
+)
+
+// ErrGenerated is the error held by the failures generated by the suites.
+var ErrGenerated = errors.New("generated failure")
+
+// GenInt generates a random integer in [-100, 100].
+func GenInt(rng *rand.Rand) int {
+	return rng.Intn(201) - 100
+}
+
+// GenIntFunc generates a random function over integers, picked among
+// additions, multiplications, subtractions and divisions by a random constant.
+func GenIntFunc(rng *rand.Rand) func(int) int {
+	c := GenInt(rng)
+	switch rng.Intn(4) {
+	case 0:
+		return func(x int) int { return x + c }
+	case 1:
+		return func(x int) int { return x * c }
+	case 2:
+		return func(x int) int { return c - x }
+	default:
+		if c == 0 {
+			c = 1
+		}
+		return func(x int) int { return x / c }
+	}
+}
+
+// genFails generates a random predicate deciding whether a generated monadic
+// function fails on its input.
+func genFails(rng *rand.Rand) func(int) bool {
+	k := rng.Intn(4) + 2
+	return func(x int) bool { return x%k == 0 }
+}
+
+// MaybeLaws describes the Maybe monad over integers.
+func MaybeLaws() Laws[int, monad.Maybe[int]] {
+	return Laws[int, monad.Maybe[int]]{
+		Name: "Maybe",
+		Pure: monad.Some[int],
+		FlatMap: func(m monad.Maybe[int], f func(int) monad.Maybe[int]) monad.Maybe[int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Maybe[int], f func(int) int) monad.Maybe[int] {
+			res := m.Map(func(x int) monad.Maybe[any] { return monad.Some[any](f(x)) })
+			if res.Nothing() {
+				return monad.None[int]()
+			}
+			return monad.Some(res.Value().(int))
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Maybe[int] {
+			if rng.Intn(4) == 0 {
+				return monad.None[int]()
+			}
+			return monad.Some(GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Maybe[int] {
+			f, fails := GenIntFunc(rng), genFails(rng)
+			return func(x int) monad.Maybe[int] {
+				if fails(x) {
+					return monad.None[int]()
+				}
+				return monad.Some(f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// ResultLaws describes the Result monad over integers.
+func ResultLaws() Laws[int, monad.Result[int, error]] {
+	return Laws[int, monad.Result[int, error]]{
+		Name: "Result",
+		Pure: monad.Succeed[int, error],
+		FlatMap: func(
+			m monad.Result[int, error], f func(int) monad.Result[int, error],
+		) monad.Result[int, error] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Result[int, error], f func(int) int) monad.Result[int, error] {
+			return fromAnyResult(m.Map(func(x int) any { return f(x) }))
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Result[int, error] {
+			if rng.Intn(4) == 0 {
+				return monad.Fail[int](ErrGenerated)
+			}
+			return monad.Succeed[int, error](GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Result[int, error] {
+			f, fails := GenIntFunc(rng), genFails(rng)
+			return func(x int) monad.Result[int, error] {
+				if fails(x) {
+					return monad.Fail[int](ErrGenerated)
+				}
+				return monad.Succeed[int, error](f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// EitherLaws describes the Either monad over integers. Since the callback of
+// Either.Map decides the side of the resulting Either, the functor laws are
+// checked with callbacks that keep the side of the mapped Either.
+func EitherLaws() Laws[int, monad.Either[int]] {
+	return Laws[int, monad.Either[int]]{
+		Name: "Either",
+		Pure: monad.NewRVal[int],
+		FlatMap: func(m monad.Either[int], f func(int) monad.Either[int]) monad.Either[int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Either[int], f func(int) int) monad.Either[int] {
+			res := m.Map(func(x int) monad.Either[any] {
+				if m.Left() {
+					return monad.NewLVal[any](f(x))
+				}
+				return monad.NewRVal[any](f(x))
+			})
+			if res.Left() {
+				return monad.NewLVal(res.Value().(int))
+			}
+			return monad.NewRVal(res.Value().(int))
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Either[int] {
+			if rng.Intn(4) == 0 {
+				return monad.NewLVal(GenInt(rng))
+			}
+			return monad.NewRVal(GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Either[int] {
+			f, fails := GenIntFunc(rng), genFails(rng)
+			return func(x int) monad.Either[int] {
+				if fails(x) {
+					return monad.NewLVal(x)
+				}
+				return monad.NewRVal(f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// ListLaws describes the List monad over integers. Lists are observed through
+// their values, a nil slice being equivalent to an empty one.
+func ListLaws() Laws[int, monad.List[int]] {
+	return Laws[int, monad.List[int]]{
+		Name: "List",
+		Pure: func(x int) monad.List[int] { return monad.NewList([]int{x}) },
+		FlatMap: func(m monad.List[int], f func(int) monad.List[int]) monad.List[int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.List[int], f func(int) int) monad.List[int] {
+			values := m.Map(func(x int) any { return f(x) }).Values()
+			ints := make([]int, len(values))
+			for i, v := range values {
+				ints[i] = v.(int)
+			}
+			return monad.NewList(ints)
+		},
+		Observe: func(m monad.List[int]) any {
+			return append([]int{}, m.Values()...)
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.List[int] {
+			values := make([]int, rng.Intn(4))
+			for i := range values {
+				values[i] = GenInt(rng)
+			}
+			return monad.NewList(values)
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.List[int] {
+			f, g := GenIntFunc(rng), GenIntFunc(rng)
+			n := rng.Intn(3)
+			return func(x int) monad.List[int] {
+				return monad.NewList([]int{f(x), g(x)}[:n])
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// IdentityLaws describes the Identity monad over integers.
+func IdentityLaws() Laws[int, monad.Identity[int]] {
+	return Laws[int, monad.Identity[int]]{
+		Name: "Identity",
+		Pure: monad.NewIdentity[int],
+		FlatMap: func(m monad.Identity[int], f func(int) monad.Identity[int]) monad.Identity[int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Identity[int], f func(int) int) monad.Identity[int] {
+			return monad.NewIdentity(m.Map(func(x int) any { return f(x) }).Value().(int))
+		},
+		GenValue: GenInt,
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Identity[int] {
+			f := GenIntFunc(rng)
+			return func(x int) monad.Identity[int] { return monad.NewIdentity(f(x)) }
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// observedEnvs are the environments and states lazy monads are run with when
+// observed.
+var observedEnvs = []int{-7, 0, 1, 42}
+
+// ReaderLaws describes the Reader monad over integers, reading an integer
+// environment. Readers are observed by running them with several environments.
+func ReaderLaws() Laws[int, monad.Reader[int, int]] {
+	return Laws[int, monad.Reader[int, int]]{
+		Name: "Reader",
+		Pure: func(x int) monad.Reader[int, int] {
+			return monad.NewReader(func(int) int { return x })
+		},
+		FlatMap: func(
+			m monad.Reader[int, int], f func(int) monad.Reader[int, int],
+		) monad.Reader[int, int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Reader[int, int], f func(int) int) monad.Reader[int, int] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			return monad.NewReader(func(env int) int { return mapped.Run(env).(int) })
+		},
+		Observe: func(m monad.Reader[int, int]) any {
+			values := make([]int, len(observedEnvs))
+			for i, env := range observedEnvs {
+				values[i] = m.Run(env)
+			}
+			return values
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Reader[int, int] {
+			f := GenIntFunc(rng)
+			return monad.NewReader(f)
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Reader[int, int] {
+			f := GenIntFunc(rng)
+			return func(x int) monad.Reader[int, int] {
+				return monad.NewReader(func(env int) int { return f(x + env) })
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// WriterLaws describes the Writer monad over integers, appending to a log of
+// strings with NewWriterWith. Writers are observed through their value and
+// output, a nil output being equivalent to an empty one.
+func WriterLaws() Laws[int, monad.Writer[[]string, int]] {
+	return Laws[int, monad.Writer[[]string, int]]{
+		Name: "Writer",
+		Pure: func(x int) monad.Writer[[]string, int] {
+			return monad.NewWriterWith[[]string](x, nil, monad.MonoidSlice[string]())
+		},
+		FlatMap: func(
+			m monad.Writer[[]string, int], f func(int) monad.Writer[[]string, int],
+		) monad.Writer[[]string, int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Writer[[]string, int], f func(int) int) monad.Writer[[]string, int] {
+			value, output := m.Map(func(x int) any { return f(x) }).Run()
+			return monad.NewWriterWith(value.(int), output, monad.MonoidSlice[string]())
+		},
+		Observe: func(m monad.Writer[[]string, int]) any {
+			value, output := m.Run()
+			return monad.NewPair(value, append([]string{}, output...))
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Writer[[]string, int] {
+			output := make([]string, rng.Intn(3))
+			for i := range output {
+				output[i] = strconv.Itoa(GenInt(rng))
+			}
+			return monad.NewWriterWith(GenInt(rng), output, monad.MonoidSlice[string]())
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Writer[[]string, int] {
+			f := GenIntFunc(rng)
+			return func(x int) monad.Writer[[]string, int] {
+				return monad.NewWriterWith(f(x), []string{strconv.Itoa(x)}, monad.MonoidSlice[string]())
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// StateLaws describes the State monad over integers, threading an integer
+// state. States are observed by running them from several initial states.
+func StateLaws() Laws[int, monad.State[int, int]] {
+	return Laws[int, monad.State[int, int]]{
+		Name: "State",
+		Pure: func(x int) monad.State[int, int] {
+			return monad.NewState(func(s int) (int, int) { return x, s })
+		},
+		FlatMap: func(
+			m monad.State[int, int], f func(int) monad.State[int, int],
+		) monad.State[int, int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.State[int, int], f func(int) int) monad.State[int, int] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			return monad.NewState(func(s int) (int, int) {
+				value, state := mapped.Run(s)
+				return value.(int), state
+			})
+		},
+		Observe: func(m monad.State[int, int]) any {
+			runs := make([]monad.Pair[int, int], len(observedEnvs))
+			for i, s := range observedEnvs {
+				runs[i] = monad.NewPair(m.Run(s))
+			}
+			return runs
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.State[int, int] {
+			f, g := GenIntFunc(rng), GenIntFunc(rng)
+			return monad.NewState(func(s int) (int, int) { return f(s), g(s) })
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.State[int, int] {
+			f, g := GenIntFunc(rng), GenIntFunc(rng)
+			return func(x int) monad.State[int, int] {
+				return monad.NewState(func(s int) (int, int) { return f(x + s), g(s) })
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// IOLaws describes the IO monad over integers. IOs are observed by performing
+// them.
+func IOLaws() Laws[int, monad.IO[int, error]] {
+	return Laws[int, monad.IO[int, error]]{
+		Name: "IO",
+		Pure: func(x int) monad.IO[int, error] {
+			return monad.ResultToIO(monad.Succeed[int, error](x))
+		},
+		FlatMap: func(m monad.IO[int, error], f func(int) monad.IO[int, error]) monad.IO[int, error] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.IO[int, error], f func(int) int) monad.IO[int, error] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			return monad.NewIO(func() monad.Result[int, error] {
+				return fromAnyResult(mapped.Perform())
+			})
+		},
+		Observe: func(m monad.IO[int, error]) any {
+			return m.Perform()
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.IO[int, error] {
+			return monad.ResultToIO(ResultLaws().GenMonad(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.IO[int, error] {
+			f := ResultLaws().GenKleisli(rng)
+			return func(x int) monad.IO[int, error] {
+				return monad.NewIO(func() monad.Result[int, error] { return f(x) })
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// FutureLaws describes the Future monad over integers. Futures are observed by
+// awaiting them.
+func FutureLaws() Laws[int, monad.Future[int, error]] {
+	return Laws[int, monad.Future[int, error]]{
+		Name: "Future",
+		Pure: func(x int) monad.Future[int, error] {
+			return monad.ResultToFuture(monad.Succeed[int, error](x))
+		},
+		FlatMap: func(
+			m monad.Future[int, error], f func(int) monad.Future[int, error],
+		) monad.Future[int, error] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Future[int, error], f func(int) int) monad.Future[int, error] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			return monad.NewFuture(func() monad.Result[int, error] {
+				return fromAnyResult(mapped.Await())
+			})
+		},
+		Observe: func(m monad.Future[int, error]) any {
+			return m.Await()
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Future[int, error] {
+			return monad.ResultToFuture(ResultLaws().GenMonad(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Future[int, error] {
+			f := ResultLaws().GenKleisli(rng)
+			return func(x int) monad.Future[int, error] {
+				return monad.NewFuture(func() monad.Result[int, error] { return f(x) })
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// ValidationLaws describes the Validation monad over integers, with string
+// errors.
+func ValidationLaws() Laws[int, monad.Validation[string, int]] {
+	return Laws[int, monad.Validation[string, int]]{
+		Name: "Validation",
+		Pure: monad.NewValid[string, int],
+		FlatMap: func(
+			m monad.Validation[string, int], f func(int) monad.Validation[string, int],
+		) monad.Validation[string, int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Validation[string, int], f func(int) int) monad.Validation[string, int] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			if !mapped.Valid() {
+				errs := mapped.Errors()
+				return monad.NewInvalid[string, int](errs[0], errs[1:]...)
+			}
+			return monad.NewValid[string](mapped.Value().(int))
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Validation[string, int] {
+			if rng.Intn(4) == 0 {
+				return monad.NewInvalid[string, int](strconv.Itoa(GenInt(rng)))
+			}
+			return monad.NewValid[string](GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Validation[string, int] {
+			f, fails := GenIntFunc(rng), genFails(rng)
+			return func(x int) monad.Validation[string, int] {
+				if fails(x) {
+					return monad.NewInvalid[string, int](strconv.Itoa(x))
+				}
+				return monad.NewValid[string](f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// IorLaws describes the Ior monad over integers, with string slices as left
+// values. Iors are observed through their left and right values.
+func IorLaws() Laws[int, monad.Ior[[]string, int]] {
+	s := monad.MonoidSlice[string]()
+	return Laws[int, monad.Ior[[]string, int]]{
+		Name: "Ior",
+		Pure: func(x int) monad.Ior[[]string, int] {
+			return monad.NewIorRight[[]string](s, x)
+		},
+		FlatMap: func(m monad.Ior[[]string, int], f func(int) monad.Ior[[]string, int]) monad.Ior[[]string, int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Ior[[]string, int], f func(int) int) monad.Ior[[]string, int] {
+			return m.FlatMap(func(x int) monad.Ior[[]string, int] {
+				return monad.NewIorRight[[]string](s, f(x))
+			})
+		},
+		Observe: func(m monad.Ior[[]string, int]) any {
+			return monad.NewPair(m.Left(), m.Right())
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Ior[[]string, int] {
+			return genIor(rng, GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Ior[[]string, int] {
+			f, seed := GenIntFunc(rng), rng.Int63()
+			return func(x int) monad.Ior[[]string, int] {
+				return genIor(rand.New(rand.NewSource(seed+int64(x))), f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// genIor returns an Ior holding x, a random warning, or both.
+func genIor(rng *rand.Rand, x int) monad.Ior[[]string, int] {
+	s, warning := monad.MonoidSlice[string](), []string{strconv.Itoa(GenInt(rng))}
+	switch rng.Intn(4) {
+	case 0:
+		return monad.NewIorLeft[[]string, int](s, warning)
+	case 1:
+		return monad.NewIorBoth(s, warning, x)
+	default:
+		return monad.NewIorRight[[]string](s, x)
+	}
+}
+
+// EvalLaws describes the Eval monad over integers. Evals are observed by
+// evaluating them, and generated with every evaluation strategy.
+func EvalLaws() Laws[int, monad.Eval[int]] {
+	return Laws[int, monad.Eval[int]]{
+		Name: "Eval",
+		Pure: monad.Now[int],
+		FlatMap: func(m monad.Eval[int], f func(int) monad.Eval[int]) monad.Eval[int] {
+			return m.FlatMap(f)
+		},
+		Map: func(m monad.Eval[int], f func(int) int) monad.Eval[int] {
+			mapped := m.Map(func(x int) any { return f(x) })
+			return monad.Later(func() int { return mapped.Value().(int) })
+		},
+		Observe: func(m monad.Eval[int]) any {
+			return m.Value()
+		},
+		GenValue: GenInt,
+		GenMonad: func(rng *rand.Rand) monad.Eval[int] {
+			return genEval(rng, GenInt(rng))
+		},
+		GenKleisli: func(rng *rand.Rand) func(int) monad.Eval[int] {
+			f := GenIntFunc(rng)
+			strategy := rng.Int()
+			return func(x int) monad.Eval[int] {
+				return genEval(rand.New(rand.NewSource(int64(strategy))), f(x))
+			}
+		},
+		GenFunc: GenIntFunc,
+	}
+}
+
+// genEval returns an Eval of x with a random evaluation strategy.
+func genEval(rng *rand.Rand, x int) monad.Eval[int] {
+	switch rng.Intn(4) {
+	case 0:
+		return monad.Now(x)
+	case 1:
+		return monad.Later(func() int { return x })
+	case 2:
+		return monad.Always(func() int { return x })
+	default:
+		return monad.Defer(func() monad.Eval[int] { return monad.Now(x) })
+	}
+}
+
+// fromAnyResult converts back the Result of a Map over integers.
+func fromAnyResult(r monad.Result[any, error]) monad.Result[int, error] {
+	if r.Failure() {
+		return monad.Fail[int](r.Error())
+	}
+	return monad.Succeed[int, error](r.Value().(int))
+}
@@ -0,0 +1,288 @@
+// Package monadvet defines an analyzer reporting common misuses of the monad
+// package:
+//   - calls to Value or Error on a Result, or to Value on a Maybe, that are
+//     not guarded by a check of Success, Failure, Just or Nothing on every
+//     path leading to them, since such calls silently return a zero value;
+//   - IO and Future values that are discarded, and therefore never performed
+//     or awaited;
+//   - calls to FromTuple whose error type does not implement error.
+//
+// The guard analysis is flow-sensitive: a call is considered guarded when it
+// is dominated by the branch of a condition on the same value that ensures it
+// holds what is being read, as in
+//
+//	if r.Failure() {
+//		return r.Error()
+//	}
+//	use(r.Value())
+package monadvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/ssa"
+)
+
+// monadPath is the import path of the monad package.
+const monadPath = "github.com/denisdubochevalier/monad"
+
+// Analyzer reports unguarded Value and Error calls, discarded IO and Future
+// values, and FromTuple calls with a non-error error type.
+var Analyzer = &analysis.Analyzer{
+	Name:     "monadvet",
+	Doc:      "report unguarded Value and Error calls, discarded IO and Future values, and FromTuple calls with a non-error type",
+	Requires: []*analysis.Analyzer{buildssa.Analyzer, inspect.Analyzer},
+	Run:      run,
+}
+
+// guard describes which checks guard an accessor: the accessor is safe on the
+// branch where method returns want.
+type guard struct {
+	method string
+	want   bool
+}
+
+// guards lists, for each monad type and accessor, the checks guarding it.
+var guards = map[string]map[string][]guard{
+	"Result": {
+		"Value": {{"Success", true}, {"Failure", false}},
+		"Error": {{"Failure", true}, {"Success", false}},
+	},
+	"Maybe": {
+		"Value": {{"Just", true}, {"Nothing", false}},
+	},
+}
+
+// safeConstructors lists the constructors whose result is known to be a
+// success or a just value, for which Value needs no guard.
+var safeConstructors = map[string]bool{
+	"Some":    true,
+	"Succeed": true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	checkFromTuple(pass)
+
+	ssaInfo := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+	for _, fn := range ssaInfo.SrcFuncs {
+		checkFunction(pass, fn)
+	}
+	return nil, nil
+}
+
+// checkFunction reports the unguarded accessors and discarded lazy values of
+// fn.
+func checkFunction(pass *analysis.Pass, fn *ssa.Function) {
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			call, ok := instr.(*ssa.Call)
+			if !ok {
+				continue
+			}
+			checkAccessor(pass, call)
+			checkDiscarded(pass, call)
+		}
+	}
+}
+
+// checkAccessor reports call if it reads the content of a Result or a Maybe
+// without being guarded.
+func checkAccessor(pass *analysis.Pass, call *ssa.Call) {
+	common := call.Common()
+	if !common.IsInvoke() {
+		return
+	}
+	typeName := monadType(common.Value.Type())
+	accessorGuards := guards[typeName][common.Method.Name()]
+	if accessorGuards == nil {
+		return
+	}
+	if isSafeConstruction(common.Value) || isGuarded(call.Block(), common.Value, accessorGuards) {
+		return
+	}
+	pass.Reportf(
+		call.Pos(), "%s.%s called without checking %s first",
+		typeName, common.Method.Name(), accessorGuards[0].method,
+	)
+}
+
+// isGuarded reports whether b can only be reached through the branch of a
+// condition on v that guards it.
+func isGuarded(b *ssa.BasicBlock, v ssa.Value, accessorGuards []guard) bool {
+	for dom := b.Idom(); dom != nil; dom = dom.Idom() {
+		branch, ok := dom.Instrs[len(dom.Instrs)-1].(*ssa.If)
+		if !ok {
+			continue
+		}
+		method, outcome, ok := condition(branch.Cond, v)
+		if !ok {
+			continue
+		}
+		for _, g := range accessorGuards {
+			if g.method != method {
+				continue
+			}
+			succ := dom.Succs[0]
+			if outcome != g.want {
+				succ = dom.Succs[1]
+			}
+			if len(succ.Preds) == 1 && succ.Dominates(b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// condition reports which method of v the condition cond calls, and the result
+// of that call for which cond holds.
+func condition(cond ssa.Value, v ssa.Value) (string, bool, bool) {
+	outcome := true
+	for {
+		not, ok := cond.(*ssa.UnOp)
+		if !ok || not.Op != token.NOT {
+			break
+		}
+		cond, outcome = not.X, !outcome
+	}
+	call, ok := cond.(*ssa.Call)
+	if !ok || !call.Common().IsInvoke() || !sameValue(call.Common().Value, v) {
+		return "", false, false
+	}
+	return call.Common().Method.Name(), outcome, true
+}
+
+// sameValue reports whether a and b denote the same value, either because
+// they are the same SSA value or because they are loaded from the same
+// address.
+func sameValue(a, b ssa.Value) bool {
+	if a == b {
+		return true
+	}
+	la, ok := a.(*ssa.UnOp)
+	if !ok || la.Op != token.MUL {
+		return false
+	}
+	lb, ok := b.(*ssa.UnOp)
+	if !ok || lb.Op != token.MUL {
+		return false
+	}
+	return sameAddress(la.X, lb.X)
+}
+
+// sameAddress reports whether a and b denote the same address: the same
+// variable, or the same field of the same variable.
+func sameAddress(a, b ssa.Value) bool {
+	if a == b {
+		return true
+	}
+	fa, ok := a.(*ssa.FieldAddr)
+	if !ok {
+		return false
+	}
+	fb, ok := b.(*ssa.FieldAddr)
+	if !ok || fa.Field != fb.Field {
+		return false
+	}
+	return sameValue(fa.X, fb.X) || sameAddress(fa.X, fb.X)
+}
+
+// isSafeConstruction reports whether v is the direct result of a constructor
+// that never builds a failure or a nothing.
+func isSafeConstruction(v ssa.Value) bool {
+	call, ok := v.(*ssa.Call)
+	if !ok {
+		return false
+	}
+	fn := call.Common().StaticCallee()
+	if fn == nil {
+		return false
+	}
+	if origin := fn.Origin(); origin != nil {
+		fn = origin
+	}
+	return fn.Pkg != nil && fn.Pkg.Pkg.Path() == monadPath && safeConstructors[fn.Name()]
+}
+
+// checkDiscarded reports call if it returns an IO or a Future that is never
+// used.
+func checkDiscarded(pass *analysis.Pass, call *ssa.Call) {
+	typeName := monadType(call.Type())
+	if typeName != "IO" && typeName != "Future" {
+		return
+	}
+	if refs := call.Referrers(); refs != nil {
+		for _, ref := range *refs {
+			if _, ok := ref.(*ssa.DebugRef); !ok {
+				return
+			}
+		}
+	}
+	verb := "performed"
+	if typeName == "Future" {
+		verb = "awaited"
+	}
+	pass.Reportf(call.Pos(), "%s value is discarded and never %s", typeName, verb)
+}
+
+// checkFromTuple reports the calls to FromTuple whose error type argument does
+// not implement error. FromTuple then tells failures apart by comparing the
+// error to its zero value, which is rarely what is meant.
+func checkFromTuple(pass *analysis.Pass) {
+	errorType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		id := calleeIdent(n.(*ast.CallExpr).Fun)
+		if id == nil {
+			return
+		}
+		fn, ok := pass.TypesInfo.Uses[id].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != monadPath || fn.Name() != "FromTuple" {
+			return
+		}
+		inst, ok := pass.TypesInfo.Instances[id]
+		if !ok || inst.TypeArgs.Len() != 2 {
+			return
+		}
+		if e := inst.TypeArgs.At(1); !types.Implements(e, errorType) {
+			pass.Reportf(n.Pos(), "FromTuple called with non-error type %s", e)
+		}
+	})
+}
+
+// calleeIdent returns the identifier naming the function called by an
+// expression such as f, pkg.f, f[T] or pkg.f[T, E].
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f
+	case *ast.SelectorExpr:
+		return f.Sel
+	case *ast.IndexExpr:
+		return calleeIdent(f.X)
+	case *ast.IndexListExpr:
+		return calleeIdent(f.X)
+	default:
+		return nil
+	}
+}
+
+// monadType returns the name of t if it is one of the generic interface types
+// of the monad package, and an empty string otherwise.
+func monadType(t types.Type) string {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return ""
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != monadPath {
+		return ""
+	}
+	return obj.Name()
+}
@@ -0,0 +1,14 @@
+package monadvet_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/denisdubochevalier/monad/monadvet"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+	analysistest.Run(t, analysistest.TestData(), monadvet.Analyzer, "a")
+}
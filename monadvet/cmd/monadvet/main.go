@@ -0,0 +1,18 @@
+// Command monadvet reports common misuses of the monad package: unguarded
+// Value and Error calls, discarded IO and Future values, and FromTuple calls
+// with a non-error type.
+//
+// Usage:
+//
+//	monadvet [flags] packages...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/denisdubochevalier/monad/monadvet"
+)
+
+func main() {
+	singlechecker.Main(monadvet.Analyzer)
+}
@@ -0,0 +1,115 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/denisdubochevalier/monad"
+)
+
+func load() monad.Result[int, error] { return nil }
+
+func find() monad.Maybe[int] { return nil }
+
+func unguarded() int {
+	r := load()
+	return r.Value() // want `Result.Value called without checking Success first`
+}
+
+func unguardedError() error {
+	return load().Error() // want `Result.Error called without checking Failure first`
+}
+
+func guardedBySuccess() int {
+	r := load()
+	if r.Success() {
+		return r.Value()
+	}
+	return 0
+}
+
+func guardedByEarlyReturn() (int, error) {
+	r := load()
+	if r.Failure() {
+		return 0, r.Error()
+	}
+	return r.Value(), nil
+}
+
+func guardedByNegation() int {
+	r := load()
+	if !r.Success() {
+		return -1
+	}
+	return r.Value()
+}
+
+func wrongBranch() int {
+	r := load()
+	if r.Success() {
+		return 0
+	}
+	return r.Value() // want `Result.Value called without checking Success first`
+}
+
+func errorOnSuccessBranch() error {
+	r := load()
+	if r.Success() {
+		return r.Error() // want `Result.Error called without checking Failure first`
+	}
+	return nil
+}
+
+func otherValueChecked() int {
+	r, other := load(), load()
+	if other.Success() {
+		return r.Value() // want `Result.Value called without checking Success first`
+	}
+	return 0
+}
+
+func guardedInClosure() func() int {
+	return func() int {
+		if m := find(); m.Just() {
+			return m.Value()
+		}
+		return find().Value() // want `Maybe.Value called without checking Just first`
+	}
+}
+
+func maybeGuardedByNothing() int {
+	m := find()
+	if m.Nothing() {
+		return 0
+	}
+	return m.Value()
+}
+
+type holder struct {
+	res monad.Result[int, error]
+}
+
+func guardedField(h *holder) int {
+	if h.res.Success() {
+		return h.res.Value()
+	}
+	return 0
+}
+
+func safeConstructors() int {
+	return monad.Some(1).Value() + monad.Succeed[int, error](2).Value()
+}
+
+func discarded() {
+	io := monad.NewIO(func() monad.Result[int, error] { return nil })
+	io.Map(func(x int) any { return x }) // want `IO value is discarded and never performed`
+	monad.NewFuture(func() monad.Result[int, error] { return nil }) // want `Future value is discarded and never awaited`
+	_ = monad.NewIO(func() monad.Result[int, error] { return nil }) // want `IO value is discarded and never performed`
+	io.Perform()
+}
+
+func fromTuple() {
+	_ = monad.FromTuple(1, errors.New("e"))
+	_ = monad.FromTuple[int, error](1, nil)
+	_ = monad.FromTuple(1, "e")          // want `FromTuple called with non-error type string`
+	_ = monad.FromTuple[int, int](1, 0) // want `FromTuple called with non-error type int`
+}
@@ -0,0 +1,39 @@
+// Package monad is a stub of the monad package, declaring what the analyzer
+// fixtures use.
+package monad
+
+type Result[T, E any] interface {
+	Error() E
+	Value() T
+	Failure() bool
+	Success() bool
+}
+
+type Maybe[T any] interface {
+	Just() bool
+	Nothing() bool
+	Value() T
+}
+
+type IO[T, E any] interface {
+	Perform() Result[T, E]
+	Map(func(T) any) IO[any, E]
+}
+
+type Future[T, E any] interface {
+	Await() Result[T, E]
+}
+
+func Succeed[T, E any](val T) Result[T, E] { return nil }
+
+func Fail[T, E any](err E) Result[T, E] { return nil }
+
+func FromTuple[T, E any](val T, err E) Result[T, E] { return nil }
+
+func Some[T any](x T) Maybe[T] { return nil }
+
+func None[T any]() Maybe[T] { return nil }
+
+func NewIO[T, E any](ioFunc func() Result[T, E]) IO[T, E] { return nil }
+
+func NewFuture[T, E any](action func() Result[T, E]) Future[T, E] { return nil }
@@ -0,0 +1,142 @@
+package monad
+
+import "fmt"
+
+// NonEmptyList represents a List holding at least one value. Since it can never
+// be empty, operations such as Head and Reduce are total and need no Maybe.
+type NonEmptyList[T any] interface {
+	// Head returns the first value of the list.
+	Head() T
+
+	// Tail returns the values following the head, which may be empty.
+	Tail() List[T]
+
+	// Values returns the values of the list, head included.
+	Values() []T
+
+	// Len returns the number of values in the list, which is at least one.
+	Len() int
+
+	// Map applies a transformation to each value of the list.
+	Map(func(T) any) NonEmptyList[any]
+
+	// FlatMap applies a transformation returning a NonEmptyList to each value
+	// and concatenates the results.
+	FlatMap(func(T) NonEmptyList[T]) NonEmptyList[T]
+
+	// Concat appends the values of another NonEmptyList to this one.
+	Concat(NonEmptyList[T]) NonEmptyList[T]
+
+	// Reduce combines the values of the list from left to right, starting from
+	// the head.
+	Reduce(func(T, T) T) T
+
+	// ToList converts the NonEmptyList to a List.
+	ToList() List[T]
+}
+
+// nonEmptyList is the concrete implementation of the NonEmptyList interface. Its
+// values always hold at least one element.
+type nonEmptyList[T any] struct {
+	values []T
+}
+
+// NewNonEmptyList creates a NonEmptyList from its head and the values
+// following it.
+func NewNonEmptyList[T any](head T, tail ...T) NonEmptyList[T] {
+	values := make([]T, 0, len(tail)+1)
+	return nonEmptyList[T]{values: append(append(values, head), tail...)}
+}
+
+// FromSlice creates a NonEmptyList holding a copy of values, or nothing if
+// values is empty.
+func FromSlice[T any](values []T) Maybe[NonEmptyList[T]] {
+	if len(values) == 0 {
+		return None[NonEmptyList[T]]()
+	}
+	return Some(NewNonEmptyList(values[0], values[1:]...))
+}
+
+// FromList creates a NonEmptyList holding the values of l, or nothing if l is
+// empty.
+func FromList[T any](l List[T]) Maybe[NonEmptyList[T]] {
+	return FromSlice(l.Values())
+}
+
+// Head returns the first value of the list.
+func (l nonEmptyList[T]) Head() T {
+	return l.values[0]
+}
+
+// Tail returns the values following the head.
+func (l nonEmptyList[T]) Tail() List[T] {
+	return NewList(append([]T{}, l.values[1:]...))
+}
+
+// Values returns a copy of the values of the list.
+func (l nonEmptyList[T]) Values() []T {
+	return append([]T{}, l.values...)
+}
+
+// Len returns the number of values in the list.
+func (l nonEmptyList[T]) Len() int {
+	return len(l.values)
+}
+
+// Map applies f to each value of the list.
+func (l nonEmptyList[T]) Map(f func(T) any) NonEmptyList[any] {
+	newValues := make([]any, len(l.values))
+	for i, v := range l.values {
+		newValues[i] = f(v)
+	}
+	return nonEmptyList[any]{values: newValues}
+}
+
+// FlatMap applies f to each value of the list and concatenates the results.
+func (l nonEmptyList[T]) FlatMap(f func(T) NonEmptyList[T]) NonEmptyList[T] {
+	var newValues []T
+	for _, v := range l.values {
+		newValues = append(newValues, f(v).Values()...)
+	}
+	return nonEmptyList[T]{values: newValues}
+}
+
+// Concat appends the values of other to the list.
+func (l nonEmptyList[T]) Concat(other NonEmptyList[T]) NonEmptyList[T] {
+	newValues := make([]T, 0, len(l.values)+other.Len())
+	newValues = append(newValues, l.values...)
+	return nonEmptyList[T]{values: append(newValues, other.Values()...)}
+}
+
+// Reduce folds the values of the list with f, starting from the head.
+func (l nonEmptyList[T]) Reduce(f func(T, T) T) T {
+	acc := l.values[0]
+	for _, v := range l.values[1:] {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// ToList converts the list to a List.
+func (l nonEmptyList[T]) ToList() List[T] {
+	return NewList(l.Values())
+}
+
+// String formats the list as NonEmptyList(values...).
+func (l nonEmptyList[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// GoString formats the list as a call to NewNonEmptyList.
+func (l nonEmptyList[T]) GoString() string {
+	return fmt.Sprintf("%#v", l)
+}
+
+// Format implements fmt.Formatter, applying the verb to each of the values.
+func (l nonEmptyList[T]) Format(f fmt.State, verb rune) {
+	values := make([]any, len(l.values))
+	for i, v := range l.values {
+		values[i] = v
+	}
+	formatCase(f, verb, "NonEmptyList", generic("NewNonEmptyList", typeName[T]()), values...)
+}
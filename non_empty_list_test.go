@@ -0,0 +1,76 @@
+package monad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonEmptyList(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	l := NewNonEmptyList(1, 2, 3)
+	is.Equal(1, l.Head())
+	is.Equal([]int{2, 3}, l.Tail().Values())
+	is.Equal([]int{1, 2, 3}, l.Values())
+	is.Equal(3, l.Len())
+	is.Equal(6, l.Reduce(func(a, b int) int { return a + b }))
+	is.Equal([]int{1, 2, 3}, l.ToList().Values())
+
+	single := NewNonEmptyList("a")
+	is.Equal("a", single.Reduce(func(a, b string) string { return a + b }))
+	is.Empty(single.Tail().Values())
+}
+
+func TestNonEmptyListMapAndFlatMap(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	l := NewNonEmptyList(1, 2)
+	is.Equal([]any{"1", "2"}, l.Map(func(x int) any { return fmt.Sprint(x) }).Values())
+	is.Equal([]int{1, 10, 2, 20}, l.FlatMap(func(x int) NonEmptyList[int] {
+		return NewNonEmptyList(x, x*10)
+	}).Values())
+	is.Equal([]int{1, 2, 3}, l.Concat(NewNonEmptyList(3)).Values())
+	is.Equal([]int{1, 2}, l.Values(), "Concat leaves the receiver unchanged")
+}
+
+func TestNonEmptyListConversions(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.True(FromSlice([]int{}).Nothing())
+	is.True(FromSlice[int](nil).Nothing())
+	is.True(FromList(NewList[int](nil)).Nothing())
+	is.Equal(Some(NewNonEmptyList(1, 2)), FromSlice([]int{1, 2}))
+	is.Equal(Some(NewNonEmptyList(1)), FromList(NewList([]int{1})))
+
+	values := []int{1, 2}
+	l := FromSlice(values).Value()
+	values[0] = 42
+	is.Equal(1, l.Head(), "FromSlice copies the values")
+	l.Values()[0] = 42
+	is.Equal(1, l.Head(), "Values returns a copy")
+}
+
+func TestNonEmptyListFormat(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	l := NewNonEmptyList("a", "b")
+	is.Equal("NonEmptyList(a, b)", l.(fmt.Stringer).String())
+	is.Equal(`monad.NewNonEmptyList[string]("a", "b")`, fmt.Sprintf("%#v", l))
+}
+
+func TestValidationAlwaysHoldsErrors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	inv := NewInvalid[string, int]("a")
+	is.False(inv.Valid())
+	is.Equal("a", inv.NonEmptyErrors().Value().Head())
+	is.False(inv.Map(func(x int) any { return x }).Valid())
+	is.True(NewValid[string](1).NonEmptyErrors().Nothing())
+}
@@ -0,0 +1,199 @@
+package monad
+
+import "cmp"
+
+// Eq describes the equality of values of type T.
+type Eq[T any] interface {
+	Equal(a, b T) bool
+}
+
+// Ord describes a total order over values of type T. Compare returns a
+// negative number when a < b, zero when a == b, and a positive number when
+// a > b.
+type Ord[T any] interface {
+	Eq[T]
+	Compare(a, b T) int
+}
+
+// eq is an Eq defined by its equality function.
+type eq[T any] struct {
+	equal func(T, T) bool
+}
+
+// NewEq creates an Eq from an equality function.
+func NewEq[T any](equal func(T, T) bool) Eq[T] {
+	return eq[T]{equal: equal}
+}
+
+// Equal tells whether a and b are equal.
+func (e eq[T]) Equal(a, b T) bool {
+	return e.equal(a, b)
+}
+
+// ord is an Ord defined by its comparison function.
+type ord[T any] struct {
+	compare func(T, T) int
+}
+
+// NewOrd creates an Ord from a comparison function.
+func NewOrd[T any](compare func(T, T) int) Ord[T] {
+	return ord[T]{compare: compare}
+}
+
+// Equal tells whether a and b are equal, that is neither is smaller.
+func (o ord[T]) Equal(a, b T) bool {
+	return o.compare(a, b) == 0
+}
+
+// Compare compares a and b.
+func (o ord[T]) Compare(a, b T) int {
+	return o.compare(a, b)
+}
+
+// EqComparable returns the Eq of a comparable type, using ==.
+func EqComparable[T comparable]() Eq[T] {
+	return NewEq(func(a, b T) bool { return a == b })
+}
+
+// EqSlice returns the Eq of slices of the same length whose elements are equal
+// according to e.
+func EqSlice[T any](e Eq[T]) Eq[[]T] {
+	return NewEq(func(a, b []T) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !e.Equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// EqMap returns the Eq of maps with the same keys whose values are equal
+// according to e.
+func EqMap[K comparable, V any](e Eq[V]) Eq[map[K]V] {
+	return NewEq(func(a, b map[K]V) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for k, va := range a {
+			vb, ok := b[k]
+			if !ok || !e.Equal(va, vb) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// EqMaybe returns the Eq of Maybe: two nothings are equal, and two just values
+// are equal when their values are equal according to e.
+func EqMaybe[T any](e Eq[T]) Eq[Maybe[T]] {
+	return NewEq(func(a, b Maybe[T]) bool {
+		if a.Nothing() || b.Nothing() {
+			return a.Nothing() == b.Nothing()
+		}
+		return e.Equal(a.Value(), b.Value())
+	})
+}
+
+// EqResult returns the Eq of Result: two successes are equal when their values
+// are equal according to eqT, and two failures when their errors are equal
+// according to eqE.
+func EqResult[T, E any](eqT Eq[T], eqE Eq[E]) Eq[Result[T, E]] {
+	return NewEq(func(a, b Result[T, E]) bool {
+		switch {
+		case a.Success() != b.Success():
+			return false
+		case a.Success():
+			return eqT.Equal(a.Value(), b.Value())
+		default:
+			return eqE.Equal(a.Error(), b.Error())
+		}
+	})
+}
+
+// EqPair returns the Eq of pairs whose elements are equal.
+func EqPair[A, B any](eqA Eq[A], eqB Eq[B]) Eq[Pair[A, B]] {
+	return NewEq(func(x, y Pair[A, B]) bool {
+		return eqA.Equal(x.First, y.First) && eqB.Equal(x.Second, y.Second)
+	})
+}
+
+// OrdOrdered returns the natural Ord of numbers and strings.
+func OrdOrdered[T cmp.Ordered]() Ord[T] {
+	return NewOrd(cmp.Compare[T])
+}
+
+// OrdReverse returns the reverse of the order o.
+func OrdReverse[T any](o Ord[T]) Ord[T] {
+	return NewOrd(func(a, b T) int { return o.Compare(b, a) })
+}
+
+// OrdSlice returns the lexicographic Ord of slices.
+func OrdSlice[T any](o Ord[T]) Ord[[]T] {
+	return NewOrd(func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if c := o.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(len(a), len(b))
+	})
+}
+
+// OrdMaybe returns the Ord of Maybe, in which nothing is smaller than any just
+// value, and just values are ordered by o.
+func OrdMaybe[T any](o Ord[T]) Ord[Maybe[T]] {
+	return NewOrd(func(a, b Maybe[T]) int {
+		switch {
+		case a.Nothing() && b.Nothing():
+			return 0
+		case a.Nothing():
+			return -1
+		case b.Nothing():
+			return 1
+		default:
+			return o.Compare(a.Value(), b.Value())
+		}
+	})
+}
+
+// OrdPair returns the lexicographic Ord of pairs.
+func OrdPair[A, B any](ordA Ord[A], ordB Ord[B]) Ord[Pair[A, B]] {
+	return NewOrd(func(x, y Pair[A, B]) int {
+		if c := ordA.Compare(x.First, y.First); c != 0 {
+			return c
+		}
+		return ordB.Compare(x.Second, y.Second)
+	})
+}
+
+// MinBy returns the smallest value of xs according to o, or nothing if xs is
+// empty. The first of several smallest values is returned.
+func MinBy[T any](o Ord[T], xs List[T]) Maybe[T] {
+	return extremum(xs, func(x, best T) bool { return o.Compare(x, best) < 0 })
+}
+
+// MaxBy returns the largest value of xs according to o, or nothing if xs is
+// empty. The first of several largest values is returned.
+func MaxBy[T any](o Ord[T], xs List[T]) Maybe[T] {
+	return extremum(xs, func(x, best T) bool { return o.Compare(x, best) > 0 })
+}
+
+// extremum returns the first value of xs that no later value is better than.
+func extremum[T any](xs List[T], better func(x, best T) bool) Maybe[T] {
+	values := xs.Values()
+	if len(values) == 0 {
+		return None[T]()
+	}
+	best := values[0]
+	for _, x := range values[1:] {
+		if better(x, best) {
+			best = x
+		}
+	}
+	return Some(best)
+}
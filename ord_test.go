@@ -0,0 +1,64 @@
+package monad
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEqInstances(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+	errTest := errors.New("test")
+
+	ints := EqComparable[int]()
+	is.True(EqSlice(ints).Equal([]int{1, 2}, []int{1, 2}))
+	is.False(EqSlice(ints).Equal([]int{1, 2}, []int{1}))
+	is.True(EqMap[string](ints).Equal(map[string]int{"a": 1}, map[string]int{"a": 1}))
+	is.False(EqMap[string](ints).Equal(map[string]int{"a": 1}, map[string]int{"b": 1}))
+
+	maybes := EqMaybe(ints)
+	is.True(maybes.Equal(None[int](), None[int]()))
+	is.True(maybes.Equal(Some(1), Some(1)))
+	is.False(maybes.Equal(Some(1), None[int]()))
+	is.False(maybes.Equal(Some(1), Some(2)))
+
+	results := EqResult(ints, NewEq(func(a, b error) bool { return errors.Is(a, b) }))
+	is.True(results.Equal(Succeed[int, error](1), Succeed[int, error](1)))
+	is.True(results.Equal(Fail[int](errTest), Fail[int](errTest)))
+	is.False(results.Equal(Succeed[int, error](1), Fail[int](errTest)))
+
+	is.True(EqPair(ints, EqComparable[string]()).Equal(NewPair(1, "a"), NewPair(1, "a")))
+	is.False(EqPair(ints, EqComparable[string]()).Equal(NewPair(1, "a"), NewPair(1, "b")))
+}
+
+func TestOrdInstances(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ints := OrdOrdered[int]()
+	is.Negative(ints.Compare(1, 2))
+	is.True(ints.Equal(2, 2))
+	is.Positive(OrdReverse(ints).Compare(1, 2))
+	is.Negative(OrdSlice(ints).Compare([]int{1, 2}, []int{1, 3}))
+	is.Negative(OrdSlice(ints).Compare([]int{1}, []int{1, 0}))
+	is.Positive(OrdPair(ints, OrdOrdered[string]()).Compare(NewPair(1, "b"), NewPair(1, "a")))
+
+	maybes := []Maybe[int]{Some(3), None[int](), Some(1)}
+	o := OrdMaybe(ints)
+	sort.Slice(maybes, func(i, j int) bool { return o.Compare(maybes[i], maybes[j]) < 0 })
+	is.Equal([]Maybe[int]{None[int](), Some(1), Some(3)}, maybes)
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	byLength := NewOrd(func(a, b string) int { return len(a) - len(b) })
+	words := NewList([]string{"bb", "a", "ccc", "d", "eee"})
+	is.Equal(Some("a"), MinBy(byLength, words))
+	is.Equal(Some("ccc"), MaxBy(byLength, words))
+	is.Equal(None[string](), MinBy(byLength, NewList([]string{})))
+}
@@ -0,0 +1,230 @@
+package monad
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ParTraverseIO returns an IO performing the IO returned by f for each value
+// of xs, running at most parallelism of them at the same time, or all of them
+// if parallelism is not positive. It succeeds with their values in the order
+// of xs.
+//
+// It fails fast: as soon as one of the IOs fails, it fails with its error and
+// stops starting new IOs, without waiting for the running ones. Use
+// ParTraverseIOWith to perform every IO and collect all the errors instead.
+//
+// A panic raised by one of the IOs is re-raised by Perform as a *PanicError.
+func ParTraverseIO[A, B, E any](xs []A, parallelism int, f func(A) IO[B, E]) IO[[]B, E] {
+	return NewIO(func() Result[[]B, E] {
+		return parTraverse(xs, parallelism, nil, f)
+	})
+}
+
+// ParTraverseIOWith is like ParTraverseIO, except that it performs every IO
+// even if some fail. It then fails with all their errors, combined with s in
+// the order of xs.
+func ParTraverseIOWith[A, B, E any](xs []A, parallelism int, s Semigroup[E], f func(A) IO[B, E]) IO[[]B, E] {
+	return NewIO(func() Result[[]B, E] {
+		return parTraverse(xs, parallelism, s, f)
+	})
+}
+
+// ParSequenceIO returns an IO performing ios, running at most parallelism of
+// them at the same time, and succeeding with their values in order. It fails
+// fast like ParTraverseIO.
+func ParSequenceIO[T, E any](ios []IO[T, E], parallelism int) IO[[]T, E] {
+	return ParTraverseIO(ios, parallelism, Id[IO[T, E]])
+}
+
+// ParSequenceIOWith is like ParSequenceIO, except that it performs every IO and
+// fails with all their errors combined with s, like ParTraverseIOWith.
+func ParSequenceIOWith[T, E any](ios []IO[T, E], parallelism int, s Semigroup[E]) IO[[]T, E] {
+	return ParTraverseIOWith(ios, parallelism, s, Id[IO[T, E]])
+}
+
+// ParZipIO returns an IO performing a and b at the same time, and succeeding
+// with both their values. It fails fast like ParTraverseIO.
+func ParZipIO[A, B, E any](a IO[A, E], b IO[B, E]) IO[Pair[A, B], E] {
+	return zipIO(a, b, nil)
+}
+
+// ParZipIOWith is like ParZipIO, except that it waits for both IOs and fails
+// with both their errors combined with s if they both fail.
+func ParZipIOWith[A, B, E any](a IO[A, E], b IO[B, E], s Semigroup[E]) IO[Pair[A, B], E] {
+	return zipIO(a, b, s)
+}
+
+// ParStreamIO returns an IO starting to perform the IO returned by f for each
+// value of xs, running at most parallelism of them at the same time, or all of
+// them if parallelism is not positive. It succeeds at once with a channel
+// receiving the results as they complete, along with the index of their value
+// in xs. The channel is closed once every IO is done.
+//
+// It fails fast: once one of the IOs fails, no new IO is started, and the
+// channel is closed once the running ones are done. Use ParStreamIOAll to
+// perform every IO instead.
+//
+// The channel is buffered, so that abandoning it does not leak goroutines.
+// A panic raised by one of the IOs is recovered, counts as a failure, and is
+// re-raised by the Result method of its StreamItem.
+func ParStreamIO[A, B, E any](xs []A, parallelism int, f func(A) IO[B, E]) IO[<-chan StreamItem[B, E], E] {
+	return streamIO(xs, parallelism, true, f)
+}
+
+// ParStreamIOAll is like ParStreamIO, except that it performs every IO even if
+// some fail.
+func ParStreamIOAll[A, B, E any](xs []A, parallelism int, f func(A) IO[B, E]) IO[<-chan StreamItem[B, E], E] {
+	return streamIO(xs, parallelism, false, f)
+}
+
+// StreamItem is the outcome of one of the IOs performed by ParStreamIO and
+// ParStreamIOAll.
+type StreamItem[B, E any] struct {
+	// Index is the index in the input of the value the IO was performed for.
+	Index int
+
+	result   Result[B, E]
+	panicked *PanicError
+}
+
+// Result returns the Result of the IO. If the IO panicked, it re-raises the
+// panic as a *PanicError instead.
+func (s StreamItem[B, E]) Result() Result[B, E] {
+	if s.panicked != nil {
+		panic(s.panicked)
+	}
+	return s.result
+}
+
+// Panic returns the panic raised by the IO, if any.
+func (s StreamItem[B, E]) Panic() Maybe[*PanicError] {
+	return FromOk(s.panicked, s.panicked != nil)
+}
+
+// parResult is the result of the IO performed for the value at index in a
+// parallel traversal, or the panic it raised.
+type parResult[B, E any] struct {
+	index    int
+	result   Result[B, E]
+	panicked *PanicError
+}
+
+// parRun starts performing the IO returned by f for each value of xs with at
+// most parallelism workers, sending their results on the returned channel,
+// which is closed once every started IO is done. Panics are recovered and
+// sent as results. If failFast is set, no IO is started once one has failed or
+// panicked.
+func parRun[A, B, E any](xs []A, parallelism int, f func(A) IO[B, E], failFast bool) <-chan parResult[B, E] {
+	if parallelism <= 0 || parallelism > len(xs) {
+		parallelism = len(xs)
+	}
+	results := make(chan parResult[B, E], len(xs))
+	indices := make(chan int, len(xs))
+	for i := range xs {
+		indices <- i
+	}
+	close(indices)
+
+	var stopped atomic.Bool
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for w := 0; w < parallelism; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if stopped.Load() {
+					return
+				}
+				r := perform(i, func() Result[B, E] { return f(xs[i]).Perform() })
+				if failFast && (r.panicked != nil || r.result.Failure()) {
+					stopped.Store(true)
+				}
+				results <- r
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
+// perform runs action, recovering the panic it raises.
+func perform[B, E any](index int, action func() Result[B, E]) (res parResult[B, E]) {
+	res.index = index
+	defer func() {
+		if r := recover(); r != nil {
+			res.panicked = asPanicError(r)
+		}
+	}()
+	res.result = action()
+	return res
+}
+
+// parTraverse performs the traversal of ParTraverseIO, collecting all the
+// errors with s if it is not nil.
+func parTraverse[A, B, E any](xs []A, parallelism int, s Semigroup[E], f func(A) IO[B, E]) Result[[]B, E] {
+	values := make([]B, len(xs))
+	errs, failed := make([]E, len(xs)), make([]bool, len(xs))
+	for r := range parRun(xs, parallelism, f, s == nil) {
+		if r.panicked != nil {
+			panic(r.panicked)
+		}
+		switch {
+		case r.result.Success():
+			values[r.index] = r.result.Value()
+		case s == nil:
+			return Fail[[]B](r.result.Error())
+		default:
+			errs[r.index], failed[r.index] = r.result.Error(), true
+		}
+	}
+
+	err := None[E]()
+	for i, e := range errs {
+		switch {
+		case !failed[i]:
+		case err.Nothing():
+			err = Some(e)
+		default:
+			err = Some(s.Combine(err.Value(), e))
+		}
+	}
+	if err.Nothing() {
+		return Succeed[[]B, E](values)
+	}
+	return Fail[[]B](err.Value())
+}
+
+// zipIO performs a and b in parallel, collecting their errors with s if it is
+// not nil.
+func zipIO[A, B, E any](a IO[A, E], b IO[B, E], s Semigroup[E]) IO[Pair[A, B], E] {
+	return NewIO(func() Result[Pair[A, B], E] {
+		ios := []IO[any, E]{a.Map(func(x A) any { return x }), b.Map(func(x B) any { return x })}
+		r := parTraverse(ios, len(ios), s, Id[IO[any, E]])
+		if r.Failure() {
+			return Fail[Pair[A, B]](r.Error())
+		}
+		return Succeed[Pair[A, B], E](NewPair(cast[A](r.Value()[0]), cast[B](r.Value()[1])))
+	})
+}
+
+// streamIO returns the IO of ParStreamIO, stopping after the first failure if
+// failFast is set.
+func streamIO[A, B, E any](
+	xs []A, parallelism int, failFast bool, f func(A) IO[B, E],
+) IO[<-chan StreamItem[B, E], E] {
+	return NewIO(func() Result[<-chan StreamItem[B, E], E] {
+		results := parRun(xs, parallelism, f, failFast)
+		out := make(chan StreamItem[B, E], len(xs))
+		go func() {
+			defer close(out)
+			for r := range results {
+				out <- StreamItem[B, E]{Index: r.index, result: r.result, panicked: r.panicked}
+			}
+		}()
+		return Succeed[<-chan StreamItem[B, E], E](out)
+	})
+}
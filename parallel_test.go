@@ -0,0 +1,271 @@
+package monad
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// trackingIO returns an IO succeeding with x after d, recording the highest
+// number of such IOs running at the same time.
+func trackingIO(x int, d time.Duration, running, peak *atomic.Int32) IO[int, error] {
+	return NewIO(func() Result[int, error] {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(d)
+		return Succeed[int, error](x)
+	})
+}
+
+// barrierIO returns a function building n IOs succeeding with x once all of
+// them are running, or failing if they do not run at the same time.
+func barrierIO(n int) func(x int) IO[int, error] {
+	var arrived sync.WaitGroup
+	arrived.Add(n)
+	all := make(chan struct{})
+	go func() {
+		arrived.Wait()
+		close(all)
+	}()
+	return func(x int) IO[int, error] {
+		return NewIO(func() Result[int, error] {
+			arrived.Done()
+			select {
+			case <-all:
+				return Succeed[int, error](x)
+			case <-time.After(time.Second):
+				return Fail[int](errors.New("the IOs do not run at the same time"))
+			}
+		})
+	}
+}
+
+func TestParTraverseIO(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps the order and bounds parallelism", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var running, peak atomic.Int32
+		xs := []int{5, 4, 3, 2, 1, 0}
+		r := ParTraverseIO(xs, 2, func(x int) IO[int, error] {
+			return trackingIO(x*10, time.Duration(x)*time.Millisecond, &running, &peak)
+		}).Perform()
+		is.Equal(Succeed[[]int, error]([]int{50, 40, 30, 20, 10, 0}), r)
+		is.LessOrEqual(peak.Load(), int32(2))
+	})
+
+	t.Run("unbounded parallelism", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		r := ParTraverseIO([]int{1, 2, 3}, 0, barrierIO(3)).Perform()
+		is.Equal(Succeed[[]int, error]([]int{1, 2, 3}), r)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		r := ParTraverseIO([]int{}, 4, func(x int) IO[int, error] { panic("unreachable") }).Perform()
+		is.Equal(Succeed[[]int, error]([]int{}), r)
+	})
+
+	t.Run("fails fast", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var started atomic.Int32
+		r := ParTraverseIO([]int{0, 1, 2, 3, 4}, 1, func(x int) IO[int, error] {
+			return NewIO(func() Result[int, error] {
+				started.Add(1)
+				if x == 1 {
+					return Fail[int](fmt.Errorf("%d failed", x))
+				}
+				return Succeed[int, error](x)
+			})
+		}).Perform()
+		is.EqualError(r.Error(), "1 failed")
+		is.LessOrEqual(started.Load(), int32(3), "no new IO starts after a failure")
+	})
+
+	t.Run("collects all the errors in order", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var performed atomic.Int32
+		s := NewSemigroup(func(a, b string) string { return a + "," + b })
+		r := ParTraverseIOWith([]int{3, 2, 1, 0}, 4, s, func(x int) IO[int, string] {
+			return NewIO(func() Result[int, string] {
+				performed.Add(1)
+				time.Sleep(time.Duration(x) * time.Millisecond)
+				if x%2 == 1 {
+					return Fail[int](fmt.Sprint(x))
+				}
+				return Succeed[int, string](x)
+			})
+		}).Perform()
+		is.Equal(Fail[[]int]("3,1"), r)
+		is.Equal(int32(4), performed.Load())
+	})
+
+	t.Run("re-raises panics", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		io := ParTraverseIO([]int{1}, 1, func(int) IO[int, error] {
+			return NewIO(func() Result[int, error] { panic("boom") })
+		})
+		is.PanicsWithError("panic: boom", func() { io.Perform() })
+	})
+}
+
+func TestParSequenceIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	ok := func(x int) IO[int, error] { return NewIO(func() Result[int, error] { return Succeed[int, error](x) }) }
+	ko := func(err error) IO[int, error] { return NewIO(func() Result[int, error] { return Fail[int](err) }) }
+	errA, errB := errors.New("a"), errors.New("b")
+
+	is.Equal([]int{1, 2, 3}, ParSequenceIO([]IO[int, error]{ok(1), ok(2), ok(3)}, 2).Perform().Value())
+	is.ErrorIs(ParSequenceIO([]IO[int, error]{ok(1), ko(errA)}, 2).Perform().Error(), errA)
+
+	joined := NewSemigroup(func(a, b error) error { return errors.Join(a, b) })
+	err := ParSequenceIOWith([]IO[int, error]{ko(errA), ok(1), ko(errB)}, 3, joined).Perform().Error()
+	is.ErrorIs(err, errA)
+	is.ErrorIs(err, errB)
+}
+
+func TestParZipIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	io := barrierIO(2)
+	a := io(1)
+	b := NewIO(func() Result[string, error] {
+		return Succeed[string, error](fmt.Sprint(io(2).Perform().Value()))
+	})
+	is.Equal(Succeed[Pair[int, string], error](NewPair(1, "2")), ParZipIO(a, b).Perform(), "both IOs run at the same time")
+
+	koA := NewIO(func() Result[int, string] { return Fail[int]("a") })
+	koB := NewIO(func() Result[string, string] { return Fail[string]("b") })
+	s := NewSemigroup(func(a, b string) string { return a + b })
+	is.True(ParZipIO(koA, koB).Perform().Failure())
+	is.Equal(Fail[Pair[int, string]]("ab"), ParZipIOWith(koA, koB, s).Perform())
+}
+
+func TestParStreamIO(t *testing.T) {
+	t.Parallel()
+
+	t.Run("yields results as they complete", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		release := map[int]chan struct{}{0: make(chan struct{}), 1: make(chan struct{})}
+		ch := ParStreamIO([]int{0, 1}, 2, func(x int) IO[string, error] {
+			return NewIO(func() Result[string, error] {
+				<-release[x]
+				return Succeed[string, error](fmt.Sprint("v", x))
+			})
+		}).Perform().Value()
+
+		close(release[1])
+		item := <-ch
+		is.Equal(1, item.Index)
+		is.Equal(Succeed[string, error]("v1"), item.Result())
+		is.True(item.Panic().Nothing())
+		close(release[0])
+		item = <-ch
+		is.Equal(0, item.Index)
+		is.Equal(Succeed[string, error]("v0"), item.Result())
+		_, open := <-ch
+		is.False(open)
+	})
+
+	t.Run("fails fast", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		ch := ParStreamIO([]int{0, 1, 2, 3}, 1, func(x int) IO[int, error] {
+			return NewIO(func() Result[int, error] {
+				if x == 1 {
+					return Fail[int](errors.New("boom"))
+				}
+				return Succeed[int, error](x)
+			})
+		}).Perform().Value()
+
+		var got []string
+		for r := range ch {
+			got = append(got, fmt.Sprint(r.Index, ":", r.Result()))
+		}
+		is.LessOrEqual(len(got), 3, "no new IO starts after a failure")
+		is.Equal([]string{"0:Ok(0)", "1:Err(boom)"}, got[:2])
+	})
+
+	t.Run("performs every IO", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var mu sync.Mutex
+		var performed []int
+		io := ParStreamIOAll([]int{0, 1, 2, 3}, 2, func(x int) IO[int, error] {
+			return NewIO(func() Result[int, error] {
+				mu.Lock()
+				performed = append(performed, x)
+				mu.Unlock()
+				if x%2 == 1 {
+					return Fail[int](errors.New("odd"))
+				}
+				return Succeed[int, error](x)
+			})
+		})
+		is.Empty(performed, "nothing runs until the IO is performed")
+
+		var got []string
+		for r := range io.Perform().Value() {
+			got = append(got, fmt.Sprint(r.Index, ":", r.Result()))
+		}
+		sort.Strings(got)
+		is.Equal("0:Ok(0) 1:Err(odd) 2:Ok(2) 3:Err(odd)", strings.Join(got, " "))
+	})
+
+	t.Run("recovers panics", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		ch := ParStreamIOAll([]int{0, 1}, 2, func(x int) IO[int, error] {
+			return NewIO(func() Result[int, error] {
+				if x == 1 {
+					panic("boom")
+				}
+				return Succeed[int, error](x)
+			})
+		}).Perform().Value()
+
+		items := map[int]StreamItem[int, error]{}
+		for item := range ch {
+			items[item.Index] = item
+		}
+		is.Len(items, 2)
+		is.Equal(Succeed[int, error](0), items[0].Result())
+		is.True(items[1].Panic().Just())
+		is.Equal("boom", items[1].Panic().Value().Value)
+		is.PanicsWithError(items[1].Panic().Value().Error(), func() { items[1].Result() })
+	})
+}
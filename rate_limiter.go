@@ -0,0 +1,214 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is the failure of the effects rejected by a RateLimiter
+// because too many callers are already waiting for a token.
+var ErrRateLimited = errors.New("monad: rate limiter queue is full")
+
+// Limiter is implemented by RateLimiter and Bulkhead, which limit the effects
+// wrapped with WrapIO, WrapFuture and WrapContinuation.
+type Limiter[E any] interface {
+	// acquire waits until an effect may run, returning the function to call
+	// once it is done, or the error it is rejected with.
+	acquire(ctx context.Context) (release func(), err error)
+
+	// reject converts the error an effect is rejected with to an E.
+	reject(err error) E
+}
+
+// WrapIO returns an IO performing i once l lets it run, or failing without
+// performing it if l rejects it.
+func WrapIO[T, E any](l Limiter[E], i IO[T, E]) IO[T, E] {
+	return NewIO(func() Result[T, E] {
+		return limit(context.Background(), l, i.Perform)
+	})
+}
+
+// WrapFuture returns a Future awaiting f once l lets it run, or failing without
+// awaiting it if l rejects it.
+func WrapFuture[T, E any](l Limiter[E], f Future[T, E]) Future[T, E] {
+	return NewFuture(func() Result[T, E] {
+		return limit(context.Background(), l, f.Await)
+	})
+}
+
+// WrapContinuation returns a Continuation running c once l lets it run, or
+// failing without running it if l rejects it. It stops waiting and fails with
+// the error of the context if the context is done first.
+func WrapContinuation[T any](l Limiter[error], c Continuation[T]) Continuation[T] {
+	return NewContinuation(func(ctx context.Context) Result[T, error] {
+		return limit(ctx, l, func() Result[T, error] { return c.Run(ctx) })
+	})
+}
+
+// limit runs action once l lets it run.
+func limit[T, E any](ctx context.Context, l Limiter[E], action func() Result[T, E]) Result[T, E] {
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return Fail[T](l.reject(err))
+	}
+	defer release()
+	return action()
+}
+
+// rejectWith returns reject, or if it is nil the function failing with the
+// error as is. It panics if reject is nil and E cannot hold an error.
+func rejectWith[E any](caller string, reject func(error) E) func(error) E {
+	if reject != nil {
+		return reject
+	}
+	if _, ok := any(ErrRateLimited).(E); !ok {
+		panic(fmt.Sprintf("monad: %s: %s cannot hold an error, set RejectError", caller, typeName[E]()))
+	}
+	return func(err error) E { return any(err).(E) }
+}
+
+// RateLimiterConfig configures a RateLimiter rejecting effects failing with
+// errors of type E. Zero fields take their documented default.
+type RateLimiterConfig[E any] struct {
+	// Interval is the time it takes for the bucket to gain a token. It is
+	// required.
+	Interval time.Duration
+
+	// Burst is the capacity of the bucket, which starts full. Defaults to 1.
+	Burst int
+
+	// MaxQueue is the number of effects which may wait for a token at the same
+	// time. Further effects are rejected with ErrRateLimited. Defaults to 0,
+	// rejecting effects rather than waiting.
+	MaxQueue int
+
+	// RejectError converts the error an effect is rejected with to an E: either
+	// ErrRateLimited or the error of its context. Defaults to returning the
+	// error as is, which requires E to be able to hold an error.
+	RejectError func(error) E
+
+	// OnAcquire, if set, is called with the time an effect waited for its
+	// token before running.
+	OnAcquire func(waited time.Duration)
+
+	// OnReject, if set, is called with the error an effect is rejected with.
+	OnReject func(err error)
+
+	// Clock tells the time to the RateLimiter. Defaults to SystemClock.
+	Clock Clock
+}
+
+// RateLimiter limits the rate effects run at with a token bucket: every effect
+// takes a token, and the bucket gains one every interval, up to its burst
+// capacity. Effects finding the bucket empty wait for their token in a queue
+// of bounded size. A RateLimiter is safe for concurrent use.
+type RateLimiter[E any] struct {
+	cfg       RateLimiterConfig[E]
+	rejectErr func(error) E
+
+	mu     sync.Mutex
+	tokens float64   // The tokens in the bucket, negative when some are reserved
+	last   time.Time // When the tokens were last updated
+	queued int       // The effects waiting for a token
+}
+
+// NewRateLimiter creates a RateLimiter with a full bucket, configured with cfg.
+// It panics if cfg.Interval is not positive, or if cfg.RejectError is nil and
+// E cannot hold an error.
+func NewRateLimiter[E any](cfg RateLimiterConfig[E]) *RateLimiter[E] {
+	if cfg.Interval <= 0 {
+		panic("monad: NewRateLimiter: Interval must be positive")
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = 1
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = SystemClock()
+	}
+	return &RateLimiter[E]{
+		cfg:       cfg,
+		rejectErr: rejectWith("NewRateLimiter", cfg.RejectError),
+		tokens:    float64(cfg.Burst),
+		last:      cfg.Clock.Now(),
+	}
+}
+
+// Tokens returns the number of tokens currently available.
+func (rl *RateLimiter[E]) Tokens() int {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.refill()
+	return int(math.Max(0, math.Floor(rl.tokens)))
+}
+
+// acquire takes a token, waiting for it if the bucket is empty and the queue
+// is not full.
+func (rl *RateLimiter[E]) acquire(ctx context.Context) (func(), error) {
+	rl.mu.Lock()
+	rl.refill()
+	rl.tokens--
+	if rl.tokens >= 0 {
+		rl.mu.Unlock()
+		rl.acquired(0)
+		return func() {}, nil
+	}
+	if rl.queued >= rl.cfg.MaxQueue {
+		rl.tokens++
+		rl.mu.Unlock()
+		return nil, rl.rejected(ErrRateLimited)
+	}
+	rl.queued++
+	wait := time.Duration(math.Ceil(-rl.tokens * float64(rl.cfg.Interval)))
+	rl.mu.Unlock()
+
+	timer := rl.cfg.Clock.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		rl.mu.Lock()
+		rl.queued--
+		rl.mu.Unlock()
+		rl.acquired(wait)
+		return func() {}, nil
+	case <-ctx.Done():
+		rl.mu.Lock()
+		rl.queued--
+		rl.tokens++
+		rl.mu.Unlock()
+		return nil, rl.rejected(ctx.Err())
+	}
+}
+
+// reject converts err to an E.
+func (rl *RateLimiter[E]) reject(err error) E {
+	return rl.rejectErr(err)
+}
+
+// refill adds the tokens gained since the last update. It must be called with
+// the lock held.
+func (rl *RateLimiter[E]) refill() {
+	now := rl.cfg.Clock.Now()
+	if elapsed := now.Sub(rl.last); elapsed > 0 {
+		rl.tokens = math.Min(float64(rl.cfg.Burst), rl.tokens+float64(elapsed)/float64(rl.cfg.Interval))
+		rl.last = now
+	}
+}
+
+// acquired reports an effect allowed to run to OnAcquire.
+func (rl *RateLimiter[E]) acquired(waited time.Duration) {
+	if rl.cfg.OnAcquire != nil {
+		rl.cfg.OnAcquire(waited)
+	}
+}
+
+// rejected reports a rejected effect to OnReject, and returns err.
+func (rl *RateLimiter[E]) rejected(err error) error {
+	if rl.cfg.OnReject != nil {
+		rl.cfg.OnReject(err)
+	}
+	return err
+}
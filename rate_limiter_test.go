@@ -0,0 +1,120 @@
+package monad
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterFailsFast(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	var rejected []error
+	rl := NewRateLimiter(RateLimiterConfig[error]{
+		Interval: time.Second,
+		Burst:    2,
+		Clock:    clock,
+		OnReject: func(err error) { rejected = append(rejected, err) },
+	})
+	p := newProbe()
+	io := WrapIO[int](rl, p.io())
+
+	is.Equal(2, rl.Tokens())
+	is.Equal(Succeed[int, error](1), io.Perform())
+	is.Equal(Succeed[int, error](2), io.Perform())
+	is.ErrorIs(io.Perform().Error(), ErrRateLimited)
+	is.Equal(2, p.count())
+	is.Equal([]error{ErrRateLimited}, rejected)
+
+	clock.Advance(time.Second)
+	is.Equal(1, rl.Tokens())
+	is.Equal(Succeed[int, error](3), io.Perform())
+	clock.Advance(10 * time.Second)
+	is.Equal(2, rl.Tokens(), "the bucket holds at most Burst tokens")
+}
+
+func TestRateLimiterWaits(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	waits := make(chan time.Duration, 3)
+	rl := NewRateLimiter(RateLimiterConfig[error]{
+		Interval:  time.Second,
+		MaxQueue:  1,
+		Clock:     clock,
+		OnAcquire: func(waited time.Duration) { waits <- waited },
+	})
+	p := newProbe()
+	io := WrapIO[int](rl, p.io())
+
+	is.Equal(Succeed[int, error](1), io.Perform())
+	is.Equal(time.Duration(0), <-waits)
+
+	done := make(chan Result[int, error])
+	go func() { done <- io.Perform() }()
+	is.Eventually(func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	is.ErrorIs(io.Perform().Error(), ErrRateLimited, "the queue is full")
+
+	clock.Advance(time.Second)
+	is.Equal(Succeed[int, error](2), <-done)
+	is.Equal(time.Second, <-waits)
+}
+
+func TestRateLimiterFuture(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	rl := NewRateLimiter(RateLimiterConfig[error]{Interval: time.Second, Clock: newFakeClock()})
+	f := func() Future[int, error] {
+		return NewFuture(func() Result[int, error] { return Succeed[int, error](1) })
+	}
+	is.Equal(Succeed[int, error](1), WrapFuture[int](rl, f()).Await())
+	is.ErrorIs(WrapFuture[int](rl, f()).Await().Error(), ErrRateLimited)
+}
+
+func TestRateLimiterContinuationCancellation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	rl := NewRateLimiter(RateLimiterConfig[error]{Interval: time.Second, MaxQueue: 1, Clock: clock})
+	c := WrapContinuation[int](rl, NewContinuation(func(context.Context) Result[int, error] {
+		return Succeed[int, error](1)
+	}))
+	is.Equal(Succeed[int, error](1), c.Run(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan Result[int, error])
+	go func() { done <- c.Run(ctx) }()
+	is.Eventually(func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	cancel()
+	is.ErrorIs((<-done).Error(), context.Canceled)
+	is.Eventually(func() bool { return clock.Waiters() == 0 }, time.Second, time.Millisecond,
+		"a cancelled effect stops its timer")
+
+	clock.Advance(time.Second)
+	is.Eventually(func() bool { return rl.Tokens() == 1 }, time.Second, time.Millisecond,
+		"a cancelled effect gives its token back")
+}
+
+func TestRateLimiterConfig(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Panics(func() { NewRateLimiter(RateLimiterConfig[error]{}) })
+	is.Panics(func() { NewRateLimiter(RateLimiterConfig[string]{Interval: time.Second}) })
+
+	rl := NewRateLimiter(RateLimiterConfig[string]{
+		Interval:    time.Second,
+		RejectError: func(err error) string { return err.Error() },
+		Clock:       newFakeClock(),
+	})
+	io := WrapIO[int](rl, NewIO(func() Result[int, string] { return Succeed[int, string](1) }))
+	io.Perform()
+	is.Equal(Fail[int](ErrRateLimited.Error()), io.Perform())
+}
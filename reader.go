@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // Reader is a generic interface representing a Reader monad.
 // It wraps a computation that reads from a shared environment of type E and produces a value of type T.
 type Reader[E, T any] interface {
@@ -14,34 +16,66 @@ type Reader[E, T any] interface {
 }
 
 // reader is a concrete implementation of the Reader interface.
-// It holds a function that defines the computation to be run with an environment.
+// It holds a function that defines the computation to be run with an
+// environment as a Trampoline, so that long chains of FlatMap run in constant
+// stack space.
 type reader[E, T any] struct {
-	computation func(E) T
+	step func(E) Trampoline[T]
 }
 
 // NewReader constructs a new Reader monad given a computation function.
 func NewReader[E, T any](computation func(E) T) Reader[E, T] {
-	return reader[E, T]{computation: computation}
+	return reader[E, T]{step: func(env E) Trampoline[T] {
+		return Done(computation(env))
+	}}
 }
 
 // Run executes the Reader computation with the provided environment and returns the resulting value.
 func (r reader[E, T]) Run(env E) T {
-	return r.computation(env)
+	return r.step(env).Run()
 }
 
 // Map applies a given function to transform the Reader's result into a new type.
 // It returns a new Reader that wraps the new computation.
 func (r reader[E, T]) Map(f func(T) any) Reader[E, any] {
-	return NewReader[E, any](func(env E) any {
-		return f(r.Run(env))
-	})
+	return reader[E, any]{step: func(env E) Trampoline[any] {
+		return readerStep[E, T](r, env).Map(f)
+	}}
 }
 
 // FlatMap applies a given function that returns a new Reader monad.
 // It returns a new Reader that wraps the combined computation.
 func (r reader[E, T]) FlatMap(f func(T) Reader[E, T]) Reader[E, T] {
-	return NewReader[E, T](func(env E) T {
-		newReader := f(r.Run(env))
-		return newReader.Run(env)
+	return reader[E, T]{step: func(env E) Trampoline[T] {
+		return readerStep[E, T](r, env).FlatMap(func(x T) Trampoline[T] {
+			return readerStep(f(x), env)
+		})
+	}}
+}
+
+// readerStep returns the computation of r with env as a Trampoline, deferring
+// it so that it runs within the loop of the Trampoline.
+func readerStep[E, T any](r Reader[E, T], env E) Trampoline[T] {
+	return More(func() Trampoline[T] {
+		if impl, ok := r.(reader[E, T]); ok {
+			return impl.step(env)
+		}
+		return Done(r.Run(env))
 	})
 }
+
+// String describes the Reader without running it.
+func (r reader[E, T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// GoString describes the Reader without running it.
+func (r reader[E, T]) GoString() string {
+	return fmt.Sprintf("%#v", r)
+}
+
+// Format implements fmt.Formatter. Since the Reader is lazy, only its type is
+// described.
+func (r reader[E, T]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "Reader", typeName[E](), typeName[T]())
+}
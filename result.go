@@ -1,6 +1,10 @@
 package monad
 
-import "reflect"
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+)
 
 // ErrorHandler represents a function that handles an error
 type ErrorHandler[T, E any] func(E) Result[T, E]
@@ -56,6 +60,27 @@ func (s success[T, E]) Or(_ ErrorHandler[T, E]) Result[T, E] {
 	return s
 }
 
+// String formats the Result as Ok(value)
+func (s success[T, E]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString formats the Result as a call to Succeed
+func (s success[T, E]) GoString() string {
+	return fmt.Sprintf("%#v", s)
+}
+
+// Format implements fmt.Formatter, applying the verb to the underlying value
+func (s success[T, E]) Format(f fmt.State, verb rune) {
+	formatCase(f, verb, "Ok", generic("Succeed", typeName[T](), typeName[E]()), s.val)
+}
+
+// LogValue implements slog.LogValuer, logging the Result as a group holding
+// ok=true and the value
+func (s success[T, E]) LogValue() slog.Value {
+	return slog.GroupValue(slog.Bool("ok", true), slog.Any("value", s.val))
+}
+
 // Succeed creates a success
 func Succeed[T, E any](val T) Result[T, E] {
 	return success[T, E]{val: val}
@@ -101,6 +126,27 @@ func (f failure[T, E]) Or(e ErrorHandler[T, E]) Result[T, E] {
 	return e(f.err)
 }
 
+// String formats the Result as Err(error)
+func (f failure[T, E]) String() string {
+	return fmt.Sprint(f)
+}
+
+// GoString formats the Result as a call to Fail
+func (f failure[T, E]) GoString() string {
+	return fmt.Sprintf("%#v", f)
+}
+
+// Format implements fmt.Formatter, applying the verb to the underlying error
+func (f failure[T, E]) Format(s fmt.State, verb rune) {
+	formatCase(s, verb, "Err", generic("Fail", typeName[T](), typeName[E]()), f.err)
+}
+
+// LogValue implements slog.LogValuer, logging the Result as a group holding
+// ok=false and the error
+func (f failure[T, E]) LogValue() slog.Value {
+	return slog.GroupValue(slog.Bool("ok", false), slog.Any("err", f.err))
+}
+
 // Fail creates a failure
 func Fail[T, E any](err E) Result[T, E] {
 	return failure[T, E]{err: err}
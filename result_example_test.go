@@ -83,10 +83,10 @@ func ExampleResult() {
 	//
 	// FlatMap:
 	// =====
-	// m8 := m1.FlatMap(func(x int) monad.Result[int, error] { return monad.Succeed[int, error](x * 2) }) -> (monad.success[int,error]){val:(int)2}
-	// m9 := m2.FlatMap(func(x int) monad.Result[int, error] { return monad.Succeed[int, error](x * 2) }) -> (monad.failure[int,error]){err:(*errors.errorString)test}
+	// m8 := m1.FlatMap(func(x int) monad.Result[int, error] { return monad.Succeed[int, error](x * 2) }) -> (monad.success[int,error])Ok(2)
+	// m9 := m2.FlatMap(func(x int) monad.Result[int, error] { return monad.Succeed[int, error](x * 2) }) -> (monad.failure[int,error])Err(test)
 	//
 	// Or:
 	// ===
-	// m10 := m1.Or(func(_ error) monad.Result[int, error]{return monad.Succeed[int, error](1)}) -> (monad.success[int,error]){val:(int)1}
+	// m10 := m1.Or(func(_ error) monad.Result[int, error]{return monad.Succeed[int, error](1)}) -> (monad.success[int,error])Ok(1)
 }
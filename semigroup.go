@@ -0,0 +1,161 @@
+package monad
+
+import "cmp"
+
+// Semigroup describes how to combine two values of type T. Combine must be
+// associative: Combine(Combine(a, b), c) == Combine(a, Combine(b, c)).
+type Semigroup[T any] interface {
+	Combine(a, b T) T
+}
+
+// Monoid is a Semigroup with an identity element: combining any value with
+// Empty, on either side, yields that value.
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+// Number is the set of the built-in numeric types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// semigroup is a Semigroup defined by its combining function.
+type semigroup[T any] struct {
+	combine func(T, T) T
+}
+
+// NewSemigroup creates a Semigroup combining values with the associative
+// function combine.
+func NewSemigroup[T any](combine func(T, T) T) Semigroup[T] {
+	return semigroup[T]{combine: combine}
+}
+
+// Combine combines a and b.
+func (s semigroup[T]) Combine(a, b T) T {
+	return s.combine(a, b)
+}
+
+// monoid is a Monoid defined by its combining function and identity element.
+type monoid[T any] struct {
+	semigroup[T]
+	empty T
+}
+
+// NewMonoid creates a Monoid combining values with the associative function
+// combine, for which empty is the identity element.
+func NewMonoid[T any](empty T, combine func(T, T) T) Monoid[T] {
+	return monoid[T]{semigroup: semigroup[T]{combine: combine}, empty: empty}
+}
+
+// Empty returns the identity element of the monoid.
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+// MonoidSum returns the Monoid adding numbers.
+func MonoidSum[N Number]() Monoid[N] {
+	return NewMonoid(0, func(a, b N) N { return a + b })
+}
+
+// MonoidProduct returns the Monoid multiplying numbers.
+func MonoidProduct[N Number]() Monoid[N] {
+	return NewMonoid(1, func(a, b N) N { return a * b })
+}
+
+// SemigroupMin returns the Semigroup keeping the smallest of two numbers or
+// strings.
+func SemigroupMin[T cmp.Ordered]() Semigroup[T] {
+	return NewSemigroup(func(a, b T) T { return min(a, b) })
+}
+
+// SemigroupMax returns the Semigroup keeping the largest of two numbers or
+// strings.
+func SemigroupMax[T cmp.Ordered]() Semigroup[T] {
+	return NewSemigroup(func(a, b T) T { return max(a, b) })
+}
+
+// MonoidString returns the Monoid concatenating strings.
+func MonoidString() Monoid[string] {
+	return NewMonoid("", func(a, b string) string { return a + b })
+}
+
+// MonoidSlice returns the Monoid concatenating slices. The combined slice
+// never shares its memory with a.
+func MonoidSlice[T any]() Monoid[[]T] {
+	return NewMonoid[[]T](nil, func(a, b []T) []T {
+		return append(a[:len(a):len(a)], b...)
+	})
+}
+
+// MonoidMap returns the Monoid merging maps, combining the values of the keys
+// present in both maps with s. The merged map is always a new map.
+func MonoidMap[K comparable, V any](s Semigroup[V]) Monoid[map[K]V] {
+	return NewMonoid[map[K]V](nil, func(a, b map[K]V) map[K]V {
+		merged := make(map[K]V, len(a)+len(b))
+		for k, v := range a {
+			merged[k] = v
+		}
+		for k, v := range b {
+			if prev, ok := merged[k]; ok {
+				v = s.Combine(prev, v)
+			}
+			merged[k] = v
+		}
+		return merged
+	})
+}
+
+// MonoidMaybe lifts a Semigroup to Maybe: just values are combined with s, and
+// nothing is the identity element.
+func MonoidMaybe[T any](s Semigroup[T]) Monoid[Maybe[T]] {
+	return NewMonoid(None[T](), func(a, b Maybe[T]) Maybe[T] {
+		switch {
+		case a.Nothing():
+			return b
+		case b.Nothing():
+			return a
+		default:
+			return Some(s.Combine(a.Value(), b.Value()))
+		}
+	})
+}
+
+// SemigroupResult lifts a Semigroup to Result: successes are combined with s,
+// and the first failure is kept otherwise.
+func SemigroupResult[T, E any](s Semigroup[T]) Semigroup[Result[T, E]] {
+	return NewSemigroup(func(a, b Result[T, E]) Result[T, E] {
+		switch {
+		case a.Failure():
+			return a
+		case b.Failure():
+			return b
+		default:
+			return Succeed[T, E](s.Combine(a.Value(), b.Value()))
+		}
+	})
+}
+
+// MonoidPair combines pairs element-wise.
+func MonoidPair[A, B any](a Monoid[A], b Monoid[B]) Monoid[Pair[A, B]] {
+	return NewMonoid(NewPair(a.Empty(), b.Empty()), func(x, y Pair[A, B]) Pair[A, B] {
+		return NewPair(a.Combine(x.First, y.First), b.Combine(x.Second, y.Second))
+	})
+}
+
+// Concat combines all the values of xs with m, returning its identity element
+// for an empty List.
+func Concat[T any](m Monoid[T], xs List[T]) T {
+	return FoldMap(m, xs, func(x T) T { return x })
+}
+
+// FoldMap maps the values of xs with f and combines the results with m.
+func FoldMap[A, T any](m Monoid[T], xs List[A], f func(A) T) T {
+	acc := m.Empty()
+	for _, x := range xs.Values() {
+		acc = m.Combine(acc, f(x))
+	}
+	return acc
+}
@@ -0,0 +1,76 @@
+package monad
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberInstances(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	xs := NewList([]int{3, 1, 4})
+	is.Equal(8, Concat(MonoidSum[int](), xs))
+	is.Equal(12, Concat(MonoidProduct[int](), xs))
+	is.Equal(0, Concat(MonoidSum[int](), NewList([]int{})))
+	is.Equal(1.5, MonoidSum[float64]().Combine(1, 0.5))
+	is.Equal(1, SemigroupMin[int]().Combine(3, 1))
+	is.Equal("b", SemigroupMax[string]().Combine("a", "b"))
+}
+
+func TestCollectionInstances(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal("abc", Concat(MonoidString(), NewList([]string{"a", "b", "c"})))
+
+	a := make([]int, 1, 4)
+	combined := MonoidSlice[int]().Combine(a, []int{2})
+	is.Equal([]int{0, 2}, combined)
+	combined[0] = 9
+	is.Equal(0, a[0], "combining slices does not alias the first one")
+	is.Nil(MonoidSlice[int]().Empty())
+
+	m := MonoidMap[string](MonoidSum[int]())
+	left := map[string]int{"a": 1, "b": 2}
+	merged := m.Combine(left, map[string]int{"b": 3, "c": 4})
+	is.Equal(map[string]int{"a": 1, "b": 5, "c": 4}, merged)
+	is.Equal(map[string]int{"a": 1, "b": 2}, left)
+}
+
+func TestMonoidMaybe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	m := MonoidMaybe(MonoidSum[int]())
+	is.Equal(Some(3), m.Combine(Some(1), Some(2)))
+	is.Equal(Some(1), m.Combine(Some(1), None[int]()))
+	is.Equal(Some(2), m.Combine(None[int](), Some(2)))
+	is.Equal(None[int](), m.Empty())
+	is.Equal(Some(6), Concat(m, NewList([]Maybe[int]{Some(1), None[int](), Some(5)})))
+}
+
+func TestSemigroupResult(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+	errFirst, errSecond := errors.New("first"), errors.New("second")
+
+	s := SemigroupResult[string, error](MonoidString())
+	is.Equal(Succeed[string, error]("ab"), s.Combine(Succeed[string, error]("a"), Succeed[string, error]("b")))
+	is.Equal(Fail[string](errFirst), s.Combine(Fail[string](errFirst), Fail[string](errSecond)))
+	is.Equal(Fail[string](errSecond), s.Combine(Succeed[string, error]("a"), Fail[string](errSecond)))
+}
+
+func TestMonoidPairAndFoldMap(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	stats := MonoidPair(MonoidSum[int](), MonoidSlice[string]())
+	words := NewList([]string{"go", "monad"})
+	total := FoldMap(stats, words, func(w string) Pair[int, []string] {
+		return NewPair(len(w), []string{w})
+	})
+	is.Equal(NewPair(7, []string{"go", "monad"}), total)
+}
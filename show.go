@@ -0,0 +1,69 @@
+package monad
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Show describes how to render values of type T as text.
+type Show[T any] interface {
+	Show(a T) string
+}
+
+// show is a Show defined by its rendering function.
+type show[T any] struct {
+	render func(T) string
+}
+
+// NewShow creates a Show from a rendering function.
+func NewShow[T any](render func(T) string) Show[T] {
+	return show[T]{render: render}
+}
+
+// Show renders a.
+func (s show[T]) Show(a T) string {
+	return s.render(a)
+}
+
+// ShowAny returns the Show rendering values with the %v verb of fmt.
+func ShowAny[T any]() Show[T] {
+	return NewShow(func(a T) string { return fmt.Sprint(a) })
+}
+
+// ShowSlice returns the Show rendering slices as [a, b, c].
+func ShowSlice[T any](s Show[T]) Show[[]T] {
+	return NewShow(func(xs []T) string {
+		parts := make([]string, len(xs))
+		for i, x := range xs {
+			parts[i] = s.Show(x)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	})
+}
+
+// ShowMaybe returns the Show rendering Maybe as Some(a) or None.
+func ShowMaybe[T any](s Show[T]) Show[Maybe[T]] {
+	return NewShow(func(m Maybe[T]) string {
+		if m.Nothing() {
+			return "None"
+		}
+		return "Some(" + s.Show(m.Value()) + ")"
+	})
+}
+
+// ShowResult returns the Show rendering Result as Ok(a) or Err(e).
+func ShowResult[T, E any](showT Show[T], showE Show[E]) Show[Result[T, E]] {
+	return NewShow(func(r Result[T, E]) string {
+		if r.Failure() {
+			return "Err(" + showE.Show(r.Error()) + ")"
+		}
+		return "Ok(" + showT.Show(r.Value()) + ")"
+	})
+}
+
+// ShowPair returns the Show rendering pairs as (a, b).
+func ShowPair[A, B any](showA Show[A], showB Show[B]) Show[Pair[A, B]] {
+	return NewShow(func(p Pair[A, B]) string {
+		return "(" + showA.Show(p.First) + ", " + showB.Show(p.Second) + ")"
+	})
+}
@@ -0,0 +1,24 @@
+package monad
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowInstances(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	quoted := NewShow(strconv.Quote)
+	is.Equal("[1, 2]", ShowSlice(ShowAny[int]()).Show([]int{1, 2}))
+	is.Equal(`Some("a")`, ShowMaybe(quoted).Show(Some("a")))
+	is.Equal("None", ShowMaybe(quoted).Show(None[string]()))
+
+	results := ShowResult(ShowAny[int](), ShowAny[error]())
+	is.Equal("Ok(1)", results.Show(Succeed[int, error](1)))
+	is.Equal("Err(boom)", results.Show(Fail[int](errors.New("boom"))))
+	is.Equal(`(1, "a")`, ShowPair(ShowAny[int](), quoted).Show(NewPair(1, "a")))
+}
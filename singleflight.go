@@ -0,0 +1,110 @@
+package monad
+
+import (
+	"context"
+	"sync"
+)
+
+// Group collapses concurrent executions of effects sharing the same key into
+// a single underlying call, whose Result is handed to every waiter. Once the
+// call completes, the next execution for the key starts a new one. The zero
+// Group is ready to use, and a Group is safe for concurrent use.
+type Group[K comparable, T, E any] struct {
+	mu    sync.Mutex
+	calls map[K]*flight[T, E]
+}
+
+// flight is a call in flight for a key of a Group.
+type flight[T, E any] struct {
+	done     chan struct{}
+	result   Result[T, E]
+	panicked *PanicError
+}
+
+// Shared returns an IO performing i, unless a call for key is already in
+// flight, in which case it waits for the Result of that call instead.
+//
+// If the shared call panics, every waiter re-raises the panic as a
+// *PanicError.
+func (g *Group[K, T, E]) Shared(key K, i IO[T, E]) IO[T, E] {
+	return NewIO(func() Result[T, E] {
+		return g.start(key, i.Perform).wait()
+	})
+}
+
+// SharedFuture returns a Future awaiting f, unless a call for key is already
+// in flight, in which case it waits for the Result of that call instead.
+func (g *Group[K, T, E]) SharedFuture(key K, f Future[T, E]) Future[T, E] {
+	return NewFuture(func() Result[T, E] {
+		return g.start(key, f.Await).wait()
+	})
+}
+
+// Forget makes the next execution for key start a new call, even if one is in
+// flight. The call in flight still completes for the waiters it already has.
+func (g *Group[K, T, E]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.calls, key)
+}
+
+// SharedContinuation returns a Continuation running c through g like Shared.
+// The shared call runs with a context which is never cancelled, but carries
+// the values of the context of the Continuation starting it. A waiter whose
+// context is done stops waiting and fails with the error of its context,
+// without cancelling the shared call.
+func SharedContinuation[K comparable, T any](g *Group[K, T, error], key K, c Continuation[T]) Continuation[T] {
+	return NewContinuation(func(ctx context.Context) Result[T, error] {
+		f := g.start(key, func() Result[T, error] {
+			return c.Run(context.WithoutCancel(ctx))
+		})
+		select {
+		case <-f.done:
+			return f.wait()
+		case <-ctx.Done():
+			return Fail[T](ctx.Err())
+		}
+	})
+}
+
+// start returns the call in flight for key, starting one running action in a
+// new goroutine if there is none.
+func (g *Group[K, T, E]) start(key K, action func() Result[T, E]) *flight[T, E] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if f, ok := g.calls[key]; ok {
+		return f
+	}
+	if g.calls == nil {
+		g.calls = map[K]*flight[T, E]{}
+	}
+	f := &flight[T, E]{done: make(chan struct{})}
+	g.calls[key] = f
+	go g.run(key, f, action)
+	return f
+}
+
+// run runs action, then hands its Result to the waiters of f.
+func (g *Group[K, T, E]) run(key K, f *flight[T, E], action func() Result[T, E]) {
+	defer close(f.done)
+	defer func() {
+		if r := recover(); r != nil {
+			f.panicked = asPanicError(r)
+		}
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if g.calls[key] == f {
+			delete(g.calls, key)
+		}
+	}()
+	f.result = action()
+}
+
+// wait waits for the call to complete and returns its Result.
+func (f *flight[T, E]) wait() Result[T, E] {
+	<-f.done
+	if f.panicked != nil {
+		panic(f.panicked)
+	}
+	return f.result
+}
@@ -0,0 +1,169 @@
+package monad
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// parked returns the number of goroutines started, directly or not, by the
+// test t whose innermost frame is in function, such as the executions waiting
+// for a call of a Group.
+func parked(t *testing.T, function string) int {
+	buf := make([]byte, 1<<20)
+	buf = buf[:runtime.Stack(buf, true)]
+
+	type goroutine struct {
+		top, creator string
+		byTest       bool
+	}
+	goroutines := map[string]goroutine{}
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		lines := strings.Split(stack, "\n")
+		if len(lines) < 2 {
+			continue
+		}
+		g := goroutine{top: lines[1]}
+		for _, line := range lines {
+			if name, creator, ok := strings.Cut(line, " in goroutine "); ok && strings.HasPrefix(name, "created by ") {
+				g.creator = creator
+				g.byTest = strings.HasSuffix(name, "."+t.Name())
+			}
+		}
+		goroutines[strings.Fields(lines[0])[1]] = g
+	}
+
+	n := 0
+	for _, g := range goroutines {
+		if !strings.Contains(g.top, function) {
+			continue
+		}
+		for !g.byTest && g.creator != "" {
+			g = goroutines[g.creator]
+		}
+		if g.byTest {
+			n++
+		}
+	}
+	return n
+}
+
+// flightWait is the function in which executions wait for a call of a Group.
+const flightWait = ".(*flight[...]).wait("
+
+func TestGroupSharesConcurrentCalls(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var g Group[string, int, error]
+	p := newGatedProbe()
+	io := g.Shared("key", p.io())
+
+	var wg sync.WaitGroup
+	results := make([]Result[int, error], 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = io.Perform()
+		}(i)
+	}
+	is.Eventually(func() bool { return parked(t, flightWait) == len(results) }, time.Second, time.Millisecond)
+	p.release()
+	wg.Wait()
+
+	is.Equal(1, p.count())
+	for _, r := range results {
+		is.Equal(Succeed[int, error](1), r)
+	}
+	is.Equal(Succeed[int, error](2), g.Shared("key", p.io()).Perform(), "a completed call is not shared")
+	is.Equal(Succeed[int, error](3), g.Shared("other", p.io()).Perform())
+}
+
+func TestGroupSharedFuture(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var g Group[int, int, error]
+	p := newGatedProbe()
+	results := make(chan Result[int, error])
+	for i := 0; i < 2; i++ {
+		f := g.SharedFuture(1, NewFuture(p.io().Perform))
+		go func() { results <- f.Await() }()
+	}
+	is.Eventually(func() bool { return parked(t, flightWait) == 2 }, time.Second, time.Millisecond)
+	p.release()
+	is.Equal(Succeed[int, error](1), <-results)
+	is.Equal(Succeed[int, error](1), <-results)
+	is.Equal(1, p.count())
+}
+
+func TestGroupForget(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var g Group[string, int, error]
+	first, second := newGatedProbe(), newGatedProbe()
+
+	done := make(chan Result[int, error])
+	go func() { done <- g.Shared("key", first.io()).Perform() }()
+	first.awaitStart()
+
+	g.Forget("key")
+	go func() { done <- g.Shared("key", second.failing()).Perform() }()
+	second.awaitStart()
+
+	second.release()
+	is.Equal(Fail[int](errTest), <-done)
+	first.release()
+	is.Equal(Succeed[int, error](1), <-done)
+}
+
+func TestSharedContinuationCancellation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var g Group[string, int, error]
+	p := newGatedProbe()
+	c := NewContinuation(func(ctx context.Context) Result[int, error] {
+		r := p.io().Perform()
+		if ctx.Err() != nil {
+			return Fail[int](ctx.Err())
+		}
+		return r
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan Result[int, error])
+	go func() { cancelled <- SharedContinuation(&g, "key", c).Run(ctx) }()
+	p.awaitStart()
+
+	waiting := make(chan Result[int, error])
+	go func() { waiting <- SharedContinuation(&g, "key", c).Run(context.Background()) }()
+	is.Eventually(func() bool {
+		return parked(t, ".SharedContinuation[") == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	is.ErrorIs((<-cancelled).Error(), context.Canceled)
+	p.release()
+	is.Equal(Succeed[int, error](1), <-waiting, "the shared call is not cancelled")
+	is.Equal(1, p.count())
+}
+
+func TestGroupPanics(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	var g Group[string, int, error]
+	io := g.Shared("key", NewIO(func() Result[int, error] { panic("boom") }))
+	is.PanicsWithError("panic: boom", func() { io.Perform() })
+	is.Equal(Succeed[int, error](1), g.Shared("key", NewIO(func() Result[int, error] {
+		return Succeed[int, error](1)
+	})).Perform(), "a panicking call is not kept in flight")
+}
@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // State is a generic interface for representing a stateful computation.
 // It wraps a value of type T and a state of type S, along with the capability to
 // transform itself using Map and FlatMap operations.
@@ -23,16 +25,20 @@ type State[S, T any] interface {
 }
 
 // state is a concrete implementation of the State interface.
-// It uses an internal function, runner, to define its stateful computation.
+// It uses an internal function, step, to define its stateful computation as a
+// Trampoline, so that long chains of FlatMap run in constant stack space.
 type state[S, T any] struct {
-	state  S              // The current state
-	val    T              // The value wrapped by the State monad
-	runner func(S) (T, S) // Function to perform the stateful computation
+	state S                              // The current state
+	val   T                              // The value wrapped by the State monad
+	step  func(S) Trampoline[Pair[T, S]] // Function to perform the stateful computation
 }
 
 // NewState creates a new State monad given a function that represents a stateful computation.
 func NewState[S, T any](f func(S) (T, S)) State[S, T] {
-	return state[S, T]{runner: f}
+	return state[S, T]{step: func(st S) Trampoline[Pair[T, S]] {
+		val, newState := f(st)
+		return Done(NewPair(val, newState))
+	}}
 }
 
 // Value returns the value wrapped by the state monad.
@@ -45,32 +51,55 @@ func (s state[S, T]) State() S {
 	return s.state
 }
 
-// Run performs the stateful computation defined by runner and returns the resulting value and state.
-// Run performs the stateful computation defined by runner and returns the resulting state.
+// Run performs the stateful computation and returns the resulting value and state.
 func (s state[S, T]) Run(state S) (T, S) {
-	return s.runner(state)
+	return s.step(state).Run().Values()
 }
 
 // Map applies a given function to the wrapped value without affecting the state.
 // It returns a new State monad with the transformed value.
-// Map applies a given function to the wrapped value without affecting the state.
 func (s state[S, T]) Map(f func(T) any) State[S, any] {
-	return NewState[S, any](func(state S) (any, S) {
-		val, newState := s.Run(state)
-		return f(val), newState
-	})
+	return state[S, any]{step: func(st S) Trampoline[Pair[any, S]] {
+		return mapTrampoline(stateStep[S, T](s, st), func(p Pair[T, S]) Pair[any, S] {
+			return NewPair[any](f(p.First), p.Second)
+		})
+	}}
 }
 
 // FlatMap applies a given function that returns a new State monad.
 // It effectively combines the state transformations of both the original and the new monad.
 func (s state[S, T]) FlatMap(f func(T) State[S, T]) State[S, T] {
-	return NewState[S, T](func(st S) (T, S) {
-		val, newState := s.runner(st) // Run the original state
-		newMonad := f(val)            // Create a new State monad via the function f
-		if newMonad, ok := newMonad.(state[S, T]); ok {
-			return newMonad.runner(newState) // Run the new State monad and return its result
+	return state[S, T]{step: func(st S) Trampoline[Pair[T, S]] {
+		return stateStep[S, T](s, st).FlatMap(func(p Pair[T, S]) Trampoline[Pair[T, S]] {
+			return stateStep(f(p.First), p.Second)
+		})
+	}}
+}
+
+// stateStep returns the computation of m from the state st as a Trampoline,
+// deferring it so that it runs within the loop of the Trampoline.
+func stateStep[S, T any](m State[S, T], st S) Trampoline[Pair[T, S]] {
+	return More(func() Trampoline[Pair[T, S]] {
+		if impl, ok := m.(state[S, T]); ok {
+			return impl.step(st)
 		}
-		// handle error if the cast is not successful, return zero values or throw panic, etc.
-		return *(new(T)), newState
+		val, newState := m.Run(st)
+		return Done(NewPair(val, newState))
 	})
 }
+
+// String describes the State without running it.
+func (s state[S, T]) String() string {
+	return fmt.Sprint(s)
+}
+
+// GoString describes the State without running it.
+func (s state[S, T]) GoString() string {
+	return fmt.Sprintf("%#v", s)
+}
+
+// Format implements fmt.Formatter. Since the State is lazy, only its type is
+// described.
+func (s state[S, T]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "State", typeName[S](), typeName[T]())
+}
@@ -78,3 +78,27 @@ func TestStateAssociativityLaw(t *testing.T) {
 	is.Equal(lhsState, rhsState)
 	is.Equal(lhsValue, rhsValue)
 }
+
+// wrappedState is a user-defined implementation of the State interface.
+type wrappedState[S, T any] struct {
+	inner State[S, T]
+}
+
+func (w wrappedState[S, T]) Value() T                                  { return w.inner.Value() }
+func (w wrappedState[S, T]) State() S                                  { return w.inner.State() }
+func (w wrappedState[S, T]) Run(s S) (T, S)                            { return w.inner.Run(s) }
+func (w wrappedState[S, T]) Map(f func(T) any) State[S, any]           { return w.inner.Map(f) }
+func (w wrappedState[S, T]) FlatMap(f func(T) State[S, T]) State[S, T] { return w.inner.FlatMap(f) }
+
+func TestStateFlatMapAcceptsAnyImplementation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	m := NewState(func(s int) (int, int) { return s + 1, s + 1 })
+	val, st := m.FlatMap(func(x int) State[int, int] {
+		return wrappedState[int, int]{NewState(func(s int) (int, int) { return x * 10, s + 1 })}
+	}).Run(0)
+
+	is.Equal(10, val)
+	is.Equal(2, st)
+}
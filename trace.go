@@ -0,0 +1,237 @@
+package monad
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Tracer starts the spans recorded around the effects wrapped by TraceIO,
+// TraceFuture, TraceContinuation and TraceStepIO. It is small enough to be
+// adapted to tracing libraries such as OpenTelemetry.
+type Tracer interface {
+	// StartSpan starts a span named name, child of the span of ctx if any. It
+	// returns a context holding the new span, along with the span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a timed operation recorded by a Tracer.
+type Span interface {
+	// End ends the span.
+	End()
+
+	// RecordError records that the operation failed with err.
+	RecordError(err error)
+
+	// SetAttribute attaches a key-value pair to the span.
+	SetAttribute(key string, value any)
+}
+
+// spanKey is the context key of the current span.
+type spanKey struct{}
+
+// ContextWithSpan returns a copy of ctx holding span. Tracers use it to
+// propagate spans to the effects they trace.
+func ContextWithSpan(ctx context.Context, span Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the span held by ctx, or a Span doing nothing if ctx
+// holds none.
+func SpanFromContext(ctx context.Context) Span {
+	if span, ok := ctx.Value(spanKey{}).(Span); ok {
+		return span
+	}
+	return noopSpan{}
+}
+
+// noopSpan is a Span doing nothing.
+type noopSpan struct{}
+
+func (noopSpan) End()                     {}
+func (noopSpan) RecordError(error)        {}
+func (noopSpan) SetAttribute(string, any) {}
+
+// TraceIO returns an IO performing i within a span named name. A failure is
+// recorded as an error of the span, and so is a panic, which is re-raised as a
+// *PanicError.
+func TraceIO[T, E any](t Tracer, name string, i IO[T, E]) IO[T, E] {
+	return NewIO(func() Result[T, E] {
+		return traced(context.Background(), t, name, func(context.Context) Result[T, E] {
+			return i.Perform()
+		})
+	})
+}
+
+// TraceFuture returns a Future awaiting f within a span named name, recording
+// failures and panics like TraceIO.
+func TraceFuture[T, E any](t Tracer, name string, f Future[T, E]) Future[T, E] {
+	return NewFuture(func() Result[T, E] {
+		return traced(context.Background(), t, name, func(context.Context) Result[T, E] {
+			return f.Await()
+		})
+	})
+}
+
+// TraceContinuation returns a Continuation running c within a span named name,
+// recording failures and panics like TraceIO. The span is a child of the span
+// of the context the Continuation is run with, and c is run with a context
+// holding the new span, so that the spans of nested Continuations form a tree.
+func TraceContinuation[T any](t Tracer, name string, c Continuation[T]) Continuation[T] {
+	return NewContinuation(func(ctx context.Context) Result[T, error] {
+		return traced(ctx, t, name, c.Run)
+	})
+}
+
+// TraceStepIO returns a function tracing the IO returned by f like TraceIO, to
+// name a step of a chain of IOs built with FlatMap.
+func TraceStepIO[T, E any](t Tracer, name string, f func(T) IO[T, E]) func(T) IO[T, E] {
+	return func(x T) IO[T, E] {
+		return TraceIO(t, name, f(x))
+	}
+}
+
+// traced runs action within a span named name, child of the span of ctx.
+func traced[T, E any](
+	ctx context.Context, t Tracer, name string, action func(context.Context) Result[T, E],
+) Result[T, E] {
+	ctx, span := t.StartSpan(ctx, name)
+	defer span.End()
+	defer func() {
+		if r := recover(); r != nil {
+			p := asPanicError(r)
+			span.RecordError(p)
+			panic(p)
+		}
+	}()
+
+	res := action(ctx)
+	span.SetAttribute("success", res.Success())
+	if res.Failure() {
+		span.RecordError(asError(res.Error()))
+	}
+	return res
+}
+
+// asError returns err if it is an error, and an error describing it otherwise.
+func asError[E any](err E) error {
+	if e, ok := any(err).(error); ok {
+		return e
+	}
+	return fmt.Errorf("%v", err)
+}
+
+// RecordedSpan is a span recorded by a RecordingTracer.
+type RecordedSpan struct {
+	// ID identifies the span within its RecordingTracer, starting from 1.
+	ID int
+
+	// ParentID is the ID of the parent span, or 0 for a root span.
+	ParentID int
+
+	// Name is the name of the span.
+	Name string
+
+	// Start and End are the times the span started and ended at. End is zero
+	// while the span is running.
+	Start, End time.Time
+
+	// Errors are the errors recorded by the span, in order.
+	Errors []error
+
+	// Attributes are the attributes of the span.
+	Attributes map[string]any
+}
+
+// Ended tells whether the span has ended.
+func (s RecordedSpan) Ended() bool {
+	return !s.End.IsZero()
+}
+
+// RecordingTracer is a Tracer recording spans in memory, for tests. It is
+// safe for concurrent use.
+type RecordingTracer struct {
+	clock Clock
+
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+// NewRecordingTracer creates a RecordingTracer timing spans with clock. A nil
+// clock stands for SystemClock.
+func NewRecordingTracer(clock Clock) *RecordingTracer {
+	if clock == nil {
+		clock = SystemClock()
+	}
+	return &RecordingTracer{clock: clock}
+}
+
+// StartSpan records the start of a span, child of the span of ctx if it was
+// started by this RecordingTracer.
+func (t *RecordingTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := &RecordedSpan{
+		ID:         len(t.spans) + 1,
+		Name:       name,
+		Start:      t.clock.Now(),
+		Attributes: map[string]any{},
+	}
+	if parent, ok := SpanFromContext(ctx).(recordingSpan); ok && parent.tracer == t {
+		s.ParentID = parent.id
+	}
+	t.spans = append(t.spans, s)
+	span := recordingSpan{tracer: t, id: s.ID}
+	return ContextWithSpan(ctx, span), span
+}
+
+// Spans returns a copy of the spans recorded so far, in the order they
+// started.
+func (t *RecordingTracer) Spans() []RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	spans := make([]RecordedSpan, len(t.spans))
+	for i, s := range t.spans {
+		spans[i] = *s
+		spans[i].Errors = append([]error(nil), s.Errors...)
+		spans[i].Attributes = make(map[string]any, len(s.Attributes))
+		for k, v := range s.Attributes {
+			spans[i].Attributes[k] = v
+		}
+	}
+	return spans
+}
+
+// recordingSpan is a Span recorded by a RecordingTracer.
+type recordingSpan struct {
+	tracer *RecordingTracer
+	id     int
+}
+
+// update applies f to the recorded span.
+func (s recordingSpan) update(f func(*RecordedSpan)) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	f(s.tracer.spans[s.id-1])
+}
+
+// End records the end time of the span, unless it already ended.
+func (s recordingSpan) End() {
+	now := s.tracer.clock.Now()
+	s.update(func(r *RecordedSpan) {
+		if r.End.IsZero() {
+			r.End = now
+		}
+	})
+}
+
+// RecordError appends err to the errors of the span.
+func (s recordingSpan) RecordError(err error) {
+	s.update(func(r *RecordedSpan) { r.Errors = append(r.Errors, err) })
+}
+
+// SetAttribute sets the attribute key of the span to value.
+func (s recordingSpan) SetAttribute(key string, value any) {
+	s.update(func(r *RecordedSpan) { r.Attributes[key] = value })
+}
@@ -0,0 +1,198 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	clock := newFakeClock()
+	tracer := NewRecordingTracer(clock)
+	i := TraceIO(tracer, "load", NewIO(func() Result[int, error] {
+		clock.Advance(time.Second)
+		return Succeed[int, error](42)
+	}))
+	is.Empty(tracer.Spans())
+
+	is.Equal(42, i.Perform().Value())
+	spans := tracer.Spans()
+	is.Len(spans, 1)
+	is.Equal("load", spans[0].Name)
+	is.Equal(1, spans[0].ID)
+	is.Zero(spans[0].ParentID)
+	is.True(spans[0].Ended())
+	is.Equal(time.Second, spans[0].End.Sub(spans[0].Start))
+	is.Empty(spans[0].Errors)
+	is.Equal(map[string]any{"success": true}, spans[0].Attributes)
+
+	i.Perform()
+	is.Len(tracer.Spans(), 2)
+}
+
+func TestTraceIORecordsFailures(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	TraceIO(tracer, "errors", NewIO(func() Result[int, error] { return Fail[int](errTest) })).Perform()
+	TraceIO(tracer, "strings", NewIO(func() Result[int, string] { return Fail[int]("boom") })).Perform()
+
+	spans := tracer.Spans()
+	is.Len(spans, 2)
+	is.Equal([]error{errTest}, spans[0].Errors)
+	is.Equal(false, spans[0].Attributes["success"])
+	is.Len(spans[1].Errors, 1)
+	is.EqualError(spans[1].Errors[0], "boom")
+}
+
+func TestTraceIORecordsPanics(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	i := TraceIO(tracer, "panic", NewIO(func() Result[int, error] { panic("boom") }))
+
+	var p *PanicError
+	func() {
+		defer func() { p, _ = recover().(*PanicError) }()
+		i.Perform()
+	}()
+	is.NotNil(p)
+	is.Equal("boom", p.Value)
+
+	spans := tracer.Spans()
+	is.Len(spans, 1)
+	is.True(spans[0].Ended())
+	is.Len(spans[0].Errors, 1)
+	is.True(errors.Is(spans[0].Errors[0], p))
+}
+
+func TestTraceStepIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	double := TraceStepIO(tracer, "double", func(x int) IO[int, error] {
+		return NewIO(func() Result[int, error] { return Succeed[int, error](x * 2) })
+	})
+	res := double(1).FlatMap(double).Perform()
+
+	is.Equal(4, res.Value())
+	spans := tracer.Spans()
+	is.Len(spans, 2)
+	is.Equal("double", spans[0].Name)
+	is.Equal("double", spans[1].Name)
+}
+
+func TestTraceFuture(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	f := TraceFuture(tracer, "fetch", NewFuture(func() Result[string, error] {
+		return Fail[string](errTest)
+	}))
+
+	is.Equal(errTest, f.Await().Error())
+	is.Equal(errTest, f.Await().Error())
+	spans := tracer.Spans()
+	is.Len(spans, 1)
+	is.Equal("fetch", spans[0].Name)
+	is.Equal([]error{errTest}, spans[0].Errors)
+}
+
+func TestTraceContinuationPropagatesSpans(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	child := TraceContinuation(tracer, "child", NewContinuation(func(ctx context.Context) Result[int, error] {
+		SpanFromContext(ctx).SetAttribute("user", "alice")
+		return Succeed[int, error](1)
+	}))
+	parent := TraceContinuation(tracer, "parent", NewContinuation(func(ctx context.Context) Result[int, error] {
+		a := child.Run(ctx)
+		b := child.Run(ctx)
+		return Succeed[int, error](a.Value() + b.Value())
+	}))
+
+	is.Equal(2, parent.Run(context.Background()).Value())
+	spans := tracer.Spans()
+	is.Len(spans, 3)
+	is.Equal("parent", spans[0].Name)
+	is.Zero(spans[0].ParentID)
+	for _, s := range spans[1:] {
+		is.Equal("child", s.Name)
+		is.Equal(spans[0].ID, s.ParentID)
+		is.Equal("alice", s.Attributes["user"])
+		is.True(s.Ended())
+	}
+}
+
+func TestTraceContinuationRecordsCancellation(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c := TraceContinuation(tracer, "wait", NewContinuation(func(ctx context.Context) Result[int, error] {
+		<-ctx.Done()
+		return Fail[int](ctx.Err())
+	}))
+
+	is.ErrorIs(c.Run(ctx).Error(), context.Canceled)
+	is.Eventually(func() bool {
+		spans := tracer.Spans()
+		return len(spans) == 1 && spans[0].Ended()
+	}, time.Second, time.Millisecond)
+	is.ErrorIs(tracer.Spans()[0].Errors[0], context.Canceled)
+}
+
+func TestSpanFromContext(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	span := SpanFromContext(context.Background())
+	is.NotNil(span)
+	span.SetAttribute("ignored", true)
+	span.RecordError(errTest)
+	span.End()
+
+	tracer := NewRecordingTracer(nil)
+	ctx, started := tracer.StartSpan(context.Background(), "root")
+	is.Equal(started, SpanFromContext(ctx))
+
+	other := NewRecordingTracer(nil)
+	other.StartSpan(ctx, "foreign")
+	is.Zero(other.Spans()[0].ParentID)
+}
+
+func TestRecordedSpansAreCopies(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tracer := NewRecordingTracer(nil)
+	_, span := tracer.StartSpan(context.Background(), "span")
+	span.SetAttribute("key", 1)
+	span.RecordError(errTest)
+
+	spans := tracer.Spans()
+	is.False(spans[0].Ended())
+	spans[0].Attributes["key"] = 2
+	spans[0].Errors[0] = nil
+
+	span.End()
+	span.End()
+	spans = tracer.Spans()
+	is.Equal(1, spans[0].Attributes["key"])
+	is.Equal([]error{errTest}, spans[0].Errors)
+	is.True(spans[0].Ended())
+}
@@ -0,0 +1,134 @@
+package monad
+
+import "fmt"
+
+// Trampoline represents a computation of a value of type T that runs in
+// constant stack space, however deeply its steps are chained. Recursive
+// functions return a Trampoline describing their next step instead of calling
+// themselves, and Run performs the steps in a loop:
+//
+//	func countDown(n int) Trampoline[int] {
+//		if n == 0 {
+//			return Done(0)
+//		}
+//		return More(func() Trampoline[int] { return countDown(n - 1) })
+//	}
+//
+// State, IO, Reader and Free rely on trampolines, so that long chains of
+// FlatMap do not overflow the stack.
+type Trampoline[T any] interface {
+	// Run performs the steps of the computation and returns its value.
+	Run() T
+
+	// Map applies a function to the value of the computation.
+	Map(func(T) any) Trampoline[any]
+
+	// FlatMap chains a computation after this one.
+	FlatMap(func(T) Trampoline[T]) Trampoline[T]
+
+	// bounce returns the step to perform, with its value erased.
+	bounce() bounce
+}
+
+// bounce is a step of a trampoline, with its value erased so that steps
+// producing values of different types can be chained: either a doneBounce, a
+// moreBounce or a bindBounce.
+type bounce any
+
+// doneBounce is a step that has produced its value.
+type doneBounce struct {
+	value any
+}
+
+// moreBounce is a step whose next step is returned by a function.
+type moreBounce struct {
+	next func() bounce
+}
+
+// bindBounce is a step followed by a computation depending on its value.
+type bindBounce struct {
+	step bounce
+	cont func(any) bounce
+}
+
+// trampoline is the concrete implementation of the Trampoline interface.
+type trampoline[T any] struct {
+	step bounce
+}
+
+// Done creates a Trampoline that has already computed its value.
+func Done[T any](value T) Trampoline[T] {
+	return trampoline[T]{step: doneBounce{value: value}}
+}
+
+// More creates a Trampoline whose steps are those of the Trampoline returned
+// by next, which is only called when the Trampoline is run.
+func More[T any](next func() Trampoline[T]) Trampoline[T] {
+	return trampoline[T]{step: moreBounce{next: func() bounce { return next().bounce() }}}
+}
+
+// Run performs the steps of the trampoline in a loop, keeping the
+// computations left to perform in a slice rather than on the stack.
+func (t trampoline[T]) Run() T {
+	var conts []func(any) bounce
+	step := t.step
+	for {
+		switch s := step.(type) {
+		case doneBounce:
+			if len(conts) == 0 {
+				return cast[T](s.value)
+			}
+			cont := conts[len(conts)-1]
+			conts = conts[:len(conts)-1]
+			step = cont(s.value)
+		case moreBounce:
+			step = s.next()
+		case bindBounce:
+			conts = append(conts, s.cont)
+			step = s.step
+		}
+	}
+}
+
+// Map applies f to the value of the trampoline.
+func (t trampoline[T]) Map(f func(T) any) Trampoline[any] {
+	return flatMapTrampoline(t, func(x T) Trampoline[any] { return Done(f(x)) })
+}
+
+// FlatMap chains f after the trampoline.
+func (t trampoline[T]) FlatMap(f func(T) Trampoline[T]) Trampoline[T] {
+	return flatMapTrampoline(t, f)
+}
+
+func (t trampoline[T]) bounce() bounce {
+	return t.step
+}
+
+// String describes the Trampoline without running it.
+func (t trampoline[T]) String() string {
+	return fmt.Sprint(t)
+}
+
+// GoString describes the Trampoline without running it.
+func (t trampoline[T]) GoString() string {
+	return fmt.Sprintf("%#v", t)
+}
+
+// Format implements fmt.Formatter. Since the Trampoline is lazy, only its type
+// is described.
+func (t trampoline[T]) Format(f fmt.State, verb rune) {
+	formatLazy(f, verb, "Trampoline", typeName[T]())
+}
+
+// flatMapTrampoline chains f after t, allowing the type of the value to change.
+func flatMapTrampoline[A, B any](t Trampoline[A], f func(A) Trampoline[B]) Trampoline[B] {
+	return trampoline[B]{step: bindBounce{
+		step: t.bounce(),
+		cont: func(x any) bounce { return f(cast[A](x)).bounce() },
+	}}
+}
+
+// mapTrampoline applies f to the value of t, allowing its type to change.
+func mapTrampoline[A, B any](t Trampoline[A], f func(A) B) Trampoline[B] {
+	return flatMapTrampoline(t, func(x A) Trampoline[B] { return Done(f(x)) })
+}
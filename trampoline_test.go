@@ -0,0 +1,178 @@
+package monad
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// steps is the length of the chains run by the stack safety tests.
+const steps = 1_000_000
+
+func countDown(n int) Trampoline[int] {
+	if n == 0 {
+		return Done(0)
+	}
+	return More(func() Trampoline[int] { return countDown(n - 1) })
+}
+
+func TestTrampoline(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Equal(42, Done(42).Run())
+	is.Equal(0, countDown(steps).Run())
+
+	doubled := Done(21).FlatMap(func(x int) Trampoline[int] { return Done(x * 2) })
+	is.Equal(42, doubled.Run())
+	is.Equal("42", Done(42).Map(func(x int) any { return "42" }).Run())
+	is.Equal("Trampoline[int]", fmt.Sprint(Done(1)))
+}
+
+func TestTrampolineIsStackSafe(t *testing.T) {
+	t.Parallel()
+
+	inc := func(x int) Trampoline[int] { return Done(x + 1) }
+
+	t.Run("left-nested FlatMap", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		tr := Done(0)
+		for i := 0; i < steps; i++ {
+			tr = tr.FlatMap(inc)
+		}
+		is.Equal(steps, tr.Run())
+	})
+
+	t.Run("right-nested FlatMap", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var loop func(int) Trampoline[int]
+		loop = func(x int) Trampoline[int] {
+			if x == steps {
+				return Done(x)
+			}
+			return Done(x).FlatMap(func(x int) Trampoline[int] { return loop(x + 1) })
+		}
+		is.Equal(steps, loop(0).Run())
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		tr := Done[any](0)
+		for i := 0; i < steps; i++ {
+			tr = tr.Map(func(x any) any { return x.(int) + 1 })
+		}
+		is.Equal(steps, tr.Run())
+	})
+}
+
+func TestStateIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	tick := func(x int) State[int, int] {
+		return NewState(func(s int) (int, int) { return x + 1, s + 2 })
+	}
+	s := NewState(func(s int) (int, int) { return 0, s })
+	for i := 0; i < steps; i++ {
+		s = s.FlatMap(tick)
+	}
+	v, st := s.Run(0)
+	is.Equal(steps, v)
+	is.Equal(2*steps, st)
+
+	var loop func(int) State[int, int]
+	loop = func(x int) State[int, int] {
+		if x == steps {
+			return NewState(func(s int) (int, int) { return x, s })
+		}
+		return tick(x).FlatMap(loop)
+	}
+	v, _ = loop(0).Run(0)
+	is.Equal(steps, v)
+}
+
+func TestIOIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	performed := 0
+	inc := func(x int) IO[int, error] {
+		return NewIO(func() Result[int, error] {
+			performed++
+			return Succeed[int, error](x + 1)
+		})
+	}
+	io := inc(-1)
+	for i := 0; i < steps; i++ {
+		io = io.FlatMap(inc)
+	}
+	is.Equal(Succeed[int, error](steps), io.Perform())
+	is.Equal(steps+1, performed)
+
+	mapped := io.Map(func(x int) any { return x * 2 })
+	for i := 0; i < steps; i++ {
+		mapped = mapped.Map(func(x any) any { return x })
+	}
+	is.Equal(Succeed[any, error](2*steps), mapped.Perform())
+}
+
+func TestReaderIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	add := func(x int) Reader[int, int] {
+		return NewReader(func(env int) int { return x + env })
+	}
+	r := add(0)
+	for i := 1; i < steps; i++ {
+		r = r.FlatMap(add)
+	}
+	is.Equal(2*steps, r.Run(2))
+}
+
+func TestFreeIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	inc := func(x int) Free[TestFunctor, int] {
+		return NewFreeOp[TestFunctor, int](TestFunctor{Value: x}, func(f TestFunctor) Free[TestFunctor, int] {
+			return NewPure[TestFunctor, int](f.Value + 1)
+		})
+	}
+	m := NewPure[TestFunctor, int](0)
+	for i := 0; i < steps; i++ {
+		m = m.FlatMap(inc)
+	}
+	is.Equal(steps, m.RunFree(interpreterInt))
+
+	var loop func(int) Free[TestFunctor, int]
+	loop = func(x int) Free[TestFunctor, int] {
+		if x == steps {
+			return NewPure[TestFunctor, int](x)
+		}
+		return inc(x).FlatMap(loop)
+	}
+	is.Equal(steps, loop(0).RunFree(interpreterInt))
+	is.Equal(2*steps, loop(0).Map(func(x int) any { return x * 2 }).RunFree(interpreterAny))
+}
+
+func TestTraverseIsStackSafe(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	xs := make([]int, steps/10)
+	for i := range xs {
+		xs[i] = i
+	}
+	r := KindToIO(Traverse(IOMonad[error](), NewList(xs), func(x int) Kind[IOKind[error], int] {
+		return IOToKind(NewIO(func() Result[int, error] { return Succeed[int, error](x) }))
+	})).Perform()
+	is.Equal(xs, r.Value().Values())
+}
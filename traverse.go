@@ -0,0 +1,98 @@
+package monad
+
+// stack is an immutable linked list, which lets branching monads such as List
+// share the values accumulated by Traverse without copying them.
+type stack[T any] struct {
+	head T
+	tail *stack[T]
+	size int
+}
+
+// push returns the stack with x on top of s.
+func (s *stack[T]) push(x T) *stack[T] {
+	size := 1
+	if s != nil {
+		size += s.size
+	}
+	return &stack[T]{head: x, tail: s, size: size}
+}
+
+// list returns the values of the stack, from the bottom to the top.
+func (s *stack[T]) list() List[T] {
+	if s == nil {
+		return NewList([]T{})
+	}
+	values := make([]T, s.size)
+	for i := s; i != nil; i = i.tail {
+		values[i.size-1] = i.head
+	}
+	return NewList(values)
+}
+
+// Traverse applies f to every value of xs in order, and collects the results
+// in the monad described by m. It stops at the first value for which the
+// monad short-circuits, such as a None or a failure.
+func Traverse[F, A, B any](m MonadInstance[F], xs List[A], f func(A) Kind[F, B]) Kind[F, List[B]] {
+	acc := Pure[F, *stack[B]](m, nil)
+	for _, x := range xs.Values() {
+		x := x
+		acc = FlatMapKind(m, acc, func(s *stack[B]) Kind[F, *stack[B]] {
+			return MapKind(m, f(x), s.push)
+		})
+	}
+	return MapKind(m, acc, (*stack[B]).list)
+}
+
+// Sequence collects the values of the monads of ks in the monad described by
+// m.
+func Sequence[F, A any](m MonadInstance[F], ks List[Kind[F, A]]) Kind[F, List[A]] {
+	return Traverse(m, ks, func(k Kind[F, A]) Kind[F, A] { return k })
+}
+
+// FoldM folds xs from the left with f, starting from z, in the monad described
+// by m.
+func FoldM[F, A, B any](m MonadInstance[F], xs List[A], z B, f func(B, A) Kind[F, B]) Kind[F, B] {
+	acc := Pure(m, z)
+	for _, x := range xs.Values() {
+		x := x
+		acc = FlatMapKind(m, acc, func(b B) Kind[F, B] { return f(b, x) })
+	}
+	return acc
+}
+
+// ReplicateM runs k n times and collects its values in the monad described by
+// m.
+func ReplicateM[F, A any](m MonadInstance[F], n int, k Kind[F, A]) Kind[F, List[A]] {
+	ks := make([]Kind[F, A], n)
+	for i := range ks {
+		ks[i] = k
+	}
+	return Sequence(m, NewList(ks))
+}
+
+// WhenM runs action when cond yields true, and does nothing otherwise.
+func WhenM[F any](m MonadInstance[F], cond Kind[F, bool], action Kind[F, struct{}]) Kind[F, struct{}] {
+	return FlatMapKind(m, cond, func(ok bool) Kind[F, struct{}] {
+		if ok {
+			return action
+		}
+		return Pure(m, struct{}{})
+	})
+}
+
+// Forever runs k over and over. It only ends when the monad short-circuits,
+// such as with a None or a failure, so the type of its value is free.
+//
+// Every iteration chains another FlatMap, so Forever is meant for the monads
+// whose FlatMap defers the function until they are run, and only runs in
+// constant stack space with the stack-safe ones: IO, State, Reader, Eval and
+// Free. With an eager monad such as Maybe, Result, Either, Validation or
+// Identity, every iteration grows the stack, so k must short-circuit within a
+// few thousand iterations.
+func Forever[F, A, B any](m MonadInstance[F], k Kind[F, A]) Kind[F, B] {
+	var loop func(A) Kind[F, B]
+	loop = func(A) Kind[F, B] {
+		return FlatMapKind(m, k, loop)
+	}
+	return FlatMapKind(m, k, loop)
+}
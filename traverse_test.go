@@ -0,0 +1,148 @@
+package monad
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraverse(t *testing.T) {
+	t.Parallel()
+
+	parse := func(s string) Kind[ResultKind[error], int] {
+		return ResultToKind(FromTuple(strconv.Atoi(s)))
+	}
+
+	t.Run("collects successes", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		r := KindToResult(Traverse(ResultMonad[error](), NewList([]string{"1", "2", "3"}), parse))
+		is.True(r.Success())
+		is.Equal([]int{1, 2, 3}, r.Value().Values())
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		r := KindToResult(Traverse(ResultMonad[error](), NewList([]string{"1", "x", "y"}), parse))
+		is.True(r.Failure())
+		is.ErrorContains(r.Error(), `"x"`)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		m := KindToMaybe(Traverse(MaybeMonad(), NewList([]int{}), func(x int) Kind[MaybeKind, int] {
+			return MaybeToKind(None[int]())
+		}))
+		is.Equal([]int{}, m.Value().Values())
+	})
+
+	t.Run("performs effects in order", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		var performed []int
+		io := KindToIO(Traverse(IOMonad[error](), NewList([]int{1, 2, 3}), func(x int) Kind[IOKind[error], int] {
+			return IOToKind(NewIO(func() Result[int, error] {
+				performed = append(performed, x)
+				return Succeed[int, error](x * 10)
+			}))
+		}))
+		is.Empty(performed)
+		is.Equal([]int{10, 20, 30}, io.Perform().Value().Values())
+		is.Equal([]int{1, 2, 3}, performed)
+	})
+}
+
+func TestSequence(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	lists := NewList([]Kind[ListKind, int]{
+		ListToKind(NewList([]int{1, 2})),
+		ListToKind(NewList([]int{3, 4})),
+	})
+	combinations := KindToList(Sequence(ListMonad(), lists)).Values()
+	values := make([][]int, len(combinations))
+	for i, c := range combinations {
+		values[i] = c.Values()
+	}
+	is.Equal([][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}, values)
+
+	maybes := NewList([]Kind[MaybeKind, int]{MaybeToKind(Some(1)), MaybeToKind(None[int]())})
+	is.True(KindToMaybe(Sequence(MaybeMonad(), maybes)).Nothing())
+}
+
+func TestFoldM(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+	errDivision := errors.New("division by zero")
+
+	divide := func(acc, x int) Kind[ResultKind[error], int] {
+		if x == 0 {
+			return ResultToKind(Fail[int](errDivision))
+		}
+		return ResultToKind(Succeed[int, error](acc / x))
+	}
+
+	r := KindToResult(FoldM(ResultMonad[error](), NewList([]int{2, 5}), 100, divide))
+	is.Equal(Succeed[int, error](10), r)
+
+	r = KindToResult(FoldM(ResultMonad[error](), NewList([]int{2, 0, 5}), 100, divide))
+	is.Equal(Fail[int](errDivision), r)
+}
+
+func TestReplicateM(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	counter := StateToKind(NewState(func(s int) (int, int) { return s, s + 1 }))
+	values, next := KindToState(ReplicateM(StateMonad[int](), 3, counter)).Run(5)
+	is.Equal([]int{5, 6, 7}, values.Values())
+	is.Equal(8, next)
+
+	r := KindToReader(ReplicateM(ReaderMonad[string](), 2, ReaderToKind(NewReader(func(s string) int {
+		return len(s)
+	}))))
+	is.Equal([]int{3, 3}, r.Run("abc").Values())
+}
+
+func TestWhenM(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	m := WriterMonad(MonoidSlice[string]())
+	log := WriterToKind(NewWriter(struct{}{}, []string{"ran"}))
+
+	ran := KindToWriter(WhenM(m, Pure(m, true), log))
+	is.Equal([]string{"ran"}, ran.Output())
+
+	skipped := KindToWriter(WhenM(m, Pure(m, false), log))
+	is.Empty(skipped.Output())
+}
+
+func TestForever(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+	errDone := errors.New("done")
+
+	count := 0
+	tick := IOToKind(NewIO(func() Result[int, error] {
+		count++
+		if count == 100_000 {
+			return Fail[int](errDone)
+		}
+		return Succeed[int, error](count)
+	}))
+	r := KindToIO(Forever[IOKind[error], int, string](IOMonad[error](), tick)).Perform()
+	is.Equal(Fail[string](errDone), r)
+	is.Equal(100_000, count)
+
+	is.True(KindToMaybe(Forever[MaybeKind, int, int](MaybeMonad(), MaybeToKind(None[int]()))).Nothing())
+}
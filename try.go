@@ -0,0 +1,102 @@
+package monad
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error produced when a panic is recovered by TryCatch or by
+// one of the panic-recovering wrappers (RecoverIO, RecoverFuture and
+// RecoverContinuation). It carries the recovered value along with the stack
+// trace of the goroutine at the time of the panic.
+type PanicError struct {
+	// Value is the value that was passed to panic.
+	Value any
+
+	// Stack is the formatted stack trace captured with runtime/debug.Stack.
+	Stack []byte
+}
+
+// Error describes the recovered panic value.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns the recovered value if it is an error, allowing errors.Is and
+// errors.As to see through the panic.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Format implements fmt.Formatter. When formatted with %+v, the stack trace is
+// printed after the error message.
+func (p *PanicError) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		_, _ = fmt.Fprintf(f, "%s\n%s", p.Error(), p.Stack)
+	case verb == 'q':
+		_, _ = fmt.Fprintf(f, "%q", p.Error())
+	default:
+		_, _ = fmt.Fprint(f, p.Error())
+	}
+}
+
+// TryCatch runs f and returns its value as a success. If f panics, the panic is
+// recovered and returned as a failure holding a *PanicError.
+func TryCatch[T any](f func() T) Result[T, error] {
+	return tryResult(func() Result[T, error] {
+		return Succeed[T, error](f())
+	})
+}
+
+// RecoverIO returns an IO that performs i and turns any panic raised while
+// doing so into a failure holding a *PanicError.
+func RecoverIO[T any](i IO[T, error]) IO[T, error] {
+	return NewIO(func() Result[T, error] {
+		return tryResult(i.Perform)
+	})
+}
+
+// RecoverFuture returns a Future that awaits f and turns any panic raised
+// while doing so into a failure holding a *PanicError. The recovered failure
+// is memoized like any other Future result.
+func RecoverFuture[T any](f Future[T, error]) Future[T, error] {
+	return NewFuture(func() Result[T, error] {
+		return tryResult(f.Await)
+	})
+}
+
+// RecoverContinuation returns a Continuation that runs c and turns any panic
+// raised by its computation into a failure holding a *PanicError.
+func RecoverContinuation[T any](c Continuation[T]) Continuation[T] {
+	return NewContinuation(func(ctx context.Context) Result[T, error] {
+		return tryResult(func() Result[T, error] {
+			return c.Run(ctx)
+		})
+	})
+}
+
+// tryResult runs f, recovering any panic into a failure holding a *PanicError.
+func tryResult[T any](f func() Result[T, error]) (res Result[T, error]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = Fail[T, error](asPanicError(r))
+		}
+	}()
+	return f()
+}
+
+// asPanicError wraps a recovered value into a *PanicError, capturing the
+// current stack. Values that already are a *PanicError are returned as is so
+// that the original stack is preserved when a panic is forwarded across
+// goroutines.
+func asPanicError(r any) *PanicError {
+	if p, ok := r.(*PanicError); ok {
+		return p
+	}
+	return &PanicError{Value: r, Stack: debug.Stack()}
+}
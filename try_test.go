@@ -0,0 +1,128 @@
+package monad
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryCatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("No panic yields a success", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		res := TryCatch(func() int { return 42 })
+		is.True(res.Success())
+		is.Equal(42, res.Value())
+	})
+
+	t.Run("Panic yields a PanicError failure", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		res := TryCatch(func() int { panic("boom") })
+		is.True(res.Failure())
+
+		var perr *PanicError
+		is.ErrorAs(res.Error(), &perr)
+		is.Equal("boom", perr.Value)
+		is.Equal("panic: boom", perr.Error())
+		is.Contains(string(perr.Stack), "TestTryCatch")
+	})
+
+	t.Run("Panic with an error unwraps to it", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		sentinel := errors.New("sentinel")
+		res := TryCatch(func() int { panic(sentinel) })
+		is.ErrorIs(res.Error(), sentinel)
+	})
+}
+
+func TestRecoverIO(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	io := NewIO(func() Result[int, error] { panic("boom") })
+	res := RecoverIO(io).Perform()
+	is.True(res.Failure())
+
+	var perr *PanicError
+	is.ErrorAs(res.Error(), &perr)
+	is.Equal("boom", perr.Value)
+
+	ok := RecoverIO(NewIO(func() Result[int, error] { return Succeed[int, error](1) }))
+	is.Equal(1, ok.Perform().Value())
+}
+
+func TestFuturePanic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("A panicking Future panics on every Await", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		f := NewFuture(func() Result[int, error] { panic("boom") })
+		for i := 0; i < 2; i++ {
+			func() {
+				defer func() {
+					perr, ok := recover().(*PanicError)
+					is.True(ok)
+					is.Equal("boom", perr.Value)
+				}()
+				f.Await()
+			}()
+		}
+	})
+
+	t.Run("RecoverFuture memoizes the panic as a failure", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		calls := 0
+		f := RecoverFuture(NewFuture(func() Result[int, error] {
+			calls++
+			panic("boom")
+		}))
+
+		first, second := f.Await(), f.Await()
+		is.True(first.Failure())
+		is.Equal(first, second)
+		is.Equal(1, calls)
+
+		var perr *PanicError
+		is.ErrorAs(first.Error(), &perr)
+		is.Equal("boom", perr.Value)
+	})
+}
+
+func TestContinuationPanic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Run forwards the panic to the caller", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		c := NewContinuation(func(ctx context.Context) Result[int, error] { panic("boom") })
+		is.Panics(func() { c.Run(context.Background()) })
+	})
+
+	t.Run("RecoverContinuation turns the panic into a failure", func(t *testing.T) {
+		t.Parallel()
+		is := require.New(t)
+
+		c := NewContinuation(func(ctx context.Context) Result[int, error] { panic("boom") })
+		res := RecoverContinuation(c).Run(context.Background())
+		is.True(res.Failure())
+
+		var perr *PanicError
+		is.ErrorAs(res.Error(), &perr)
+		is.Equal("boom", perr.Value)
+		is.Contains(string(perr.Stack), "TestContinuationPanic")
+	})
+}
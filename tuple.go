@@ -0,0 +1,17 @@
+package monad
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from its two values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Values returns both values of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
@@ -1,7 +1,13 @@
 package monad
 
+import (
+	"fmt"
+	"log/slog"
+)
+
 // Validation is an interface that models the Validation monad.
 // It either contains a value of type T or an aggregated list of errors of type E.
+// An invalid Validation always holds at least one error.
 type Validation[E, T any] interface {
 	// Valid returns true if Validation contains a value, false otherwise.
 	Valid() bool
@@ -9,9 +15,14 @@ type Validation[E, T any] interface {
 	// Value returns the encapsulated value of type T.
 	Value() T
 
-	// Errors returns the aggregated errors of type E.
+	// Errors returns the aggregated errors of type E, or nil if the
+	// Validation is valid.
 	Errors() []E
 
+	// NonEmptyErrors returns the aggregated errors of type E, which are
+	// present only if the Validation is invalid.
+	NonEmptyErrors() Maybe[NonEmptyList[E]]
+
 	// Map applies a transformation to the encapsulated value, assuming it's valid,
 	// and returns a new Validation instance.
 	Map(func(T) any) Validation[E, any]
@@ -24,7 +35,7 @@ type Validation[E, T any] interface {
 // validation is a concrete implementation of the Validation interface.
 type validation[E, T any] struct {
 	value  T
-	errors []E
+	errors NonEmptyList[E]
 }
 
 // NewValid returns a new Validation containing a value.
@@ -32,9 +43,9 @@ func NewValid[E, T any](value T) Validation[E, T] {
 	return validation[E, T]{value: value, errors: nil}
 }
 
-// NewInvalid returns a new Validation containing an array of errors.
-func NewInvalid[E, T any](errors []E) Validation[E, T] {
-	return validation[E, T]{errors: errors}
+// NewInvalid returns a new Validation containing err and the other errors errs.
+func NewInvalid[E, T any](err E, errs ...E) Validation[E, T] {
+	return validation[E, T]{errors: NewNonEmptyList(err, errs...)}
 }
 
 // Valid checks if the Validation instance contains a value, not errors.
@@ -47,9 +58,20 @@ func (v validation[E, T]) Value() T {
 	return v.value
 }
 
-// Errors returns the encapsulated errors.
+// Errors returns the encapsulated errors, or nil if v is valid.
 func (v validation[E, T]) Errors() []E {
-	return v.errors
+	if v.Valid() {
+		return nil
+	}
+	return v.errors.Values()
+}
+
+// NonEmptyErrors returns the encapsulated errors, if v is invalid.
+func (v validation[E, T]) NonEmptyErrors() Maybe[NonEmptyList[E]] {
+	if v.Valid() {
+		return None[NonEmptyList[E]]()
+	}
+	return Some(v.errors)
 }
 
 // Map applies a function to the encapsulated value (if present)
@@ -59,7 +81,7 @@ func (v validation[E, T]) Map(f func(T) any) Validation[E, any] {
 		newValue := f(v.value)
 		return NewValid[E, any](newValue)
 	}
-	return NewInvalid[E, any](v.errors)
+	return validation[E, any]{errors: v.errors}
 }
 
 // FlatMap applies a function to the encapsulated value (if present)
@@ -71,3 +93,33 @@ func (v validation[E, T]) FlatMap(f func(T) Validation[E, T]) Validation[E, T] {
 	}
 	return v
 }
+
+// String formats the Validation as Valid(value) or Invalid(errors...).
+func (v validation[E, T]) String() string {
+	return fmt.Sprint(v)
+}
+
+// GoString formats the Validation as a call to NewValid or NewInvalid.
+func (v validation[E, T]) GoString() string {
+	return fmt.Sprintf("%#v", v)
+}
+
+// Format implements fmt.Formatter, applying the verb to the encapsulated value
+// or to each of the errors.
+func (v validation[E, T]) Format(f fmt.State, verb rune) {
+	if v.Valid() {
+		formatCase(f, verb, "Valid", generic("NewValid", typeName[E](), typeName[T]()), v.value)
+		return
+	}
+	errs := v.errors.Map(func(err E) any { return err }).Values()
+	formatCase(f, verb, "Invalid", generic("NewInvalid", typeName[E](), typeName[T]()), errs...)
+}
+
+// LogValue implements slog.LogValuer, logging the Validation as a group holding
+// valid=true and the value, or valid=false and the errors.
+func (v validation[E, T]) LogValue() slog.Value {
+	if v.Valid() {
+		return slog.GroupValue(slog.Bool("valid", true), slog.Any("value", v.value))
+	}
+	return slog.GroupValue(slog.Bool("valid", false), slog.Any("errors", v.errors.Values()))
+}
@@ -24,7 +24,7 @@ func TestValidationMap(t *testing.T) {
 		t.Parallel()
 		is := require.New(t)
 
-		inv := monad.NewInvalid[string, int]([]string{"error1", "error2"})
+		inv := monad.NewInvalid[string, int]("error1", "error2")
 		newInv := inv.Map(func(x int) any { return x * 2 })
 		is.False(newInv.Valid())
 		is.Equal([]string{"error1", "error2"}, newInv.Errors())
@@ -50,7 +50,7 @@ func TestValidationFlatMap(t *testing.T) {
 		t.Parallel()
 		is := require.New(t)
 
-		inv := monad.NewInvalid[string, int]([]string{"error1", "error2"})
+		inv := monad.NewInvalid[string, int]("error1", "error2")
 		newInv := inv.FlatMap(func(x int) monad.Validation[string, int] {
 			return monad.NewValid[string, int](x * 2)
 		})
@@ -109,3 +109,18 @@ func TestValidationMonadicLaws(t *testing.T) {
 		},
 	)
 }
+
+func TestValidationErrors(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	is.Empty(monad.NewValid[string](1).Errors())
+	is.Empty(monad.NewValid[string](1).Map(func(x int) any { return x }).Errors())
+	is.True(monad.NewValid[string](1).NonEmptyErrors().Nothing())
+
+	invalid := monad.NewInvalid[string, int]("error1", "error2")
+	is.Equal([]string{"error1", "error2"}, invalid.Errors())
+	errs := invalid.NonEmptyErrors()
+	is.True(errs.Just())
+	is.Equal(2, errs.Value().Len())
+}
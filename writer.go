@@ -1,5 +1,7 @@
 package monad
 
+import "fmt"
+
 // Writer is a generic interface for representing a writer monad.
 // It wraps a value of type T and an output of type W, offering methods
 // to perform transformations using Map and FlatMap.
@@ -23,23 +25,27 @@ type Writer[W, T any] interface {
 }
 
 // writer is a concrete implementation of the Writer interface.
-// It holds an encapsulated value and a writer output, along with a writer function
-// to define the computation.
+// It holds an encapsulated value and a writer output, along with the Semigroup
+// used to combine outputs when chaining writers with FlatMap.
 type writer[W, T any] struct {
-	value  T                 // The encapsulated value
-	output W                 // The writer output
-	writer func(T, W) (T, W) // The writer function to define the computation
+	value   T            // The encapsulated value
+	output  W            // The writer output
+	combine Semigroup[W] // The Semigroup combining outputs, if any
 }
 
 // NewWriter constructs a new Writer monad given an initial value and initial output.
+// FlatMap replaces the output with the one of the new Writer: use NewWriterWith
+// to combine outputs instead.
 func NewWriter[W, T any](initialValue T, initialOutput W) Writer[W, T] {
-	return writer[W, T]{
-		value:  initialValue,
-		output: initialOutput,
-		writer: func(value T, output W) (T, W) {
-			return value, output
-		},
-	}
+	return writer[W, T]{value: initialValue, output: initialOutput}
+}
+
+// NewWriterWith constructs a new Writer monad given an initial value, an
+// initial output, and the Semigroup FlatMap uses to append the output of the
+// new Writer to the current one. Such Writers obey the monad laws, Writers
+// created with the empty output of a Monoid being the identity.
+func NewWriterWith[W, T any](initialValue T, initialOutput W, s Semigroup[W]) Writer[W, T] {
+	return writer[W, T]{value: initialValue, output: initialOutput, combine: s}
 }
 
 // Value returns the encapsulated value of the writer monad.
@@ -54,34 +60,56 @@ func (w writer[W, T]) Output() W {
 
 // Run performs the writer computation and returns the encapsulated value and writer output.
 func (w writer[W, T]) Run() (T, W) {
-	return w.writer(w.value, w.output)
+	return w.value, w.output
 }
 
 // Map applies a given function to transform the encapsulated value,
 // while keeping the output unchanged. It returns a new Writer monad with the transformed value.
 func (w writer[W, T]) Map(f func(T) any) Writer[W, any] {
-	newValue := f(w.value)
 	return writer[W, any]{
-		value:  newValue,
-		output: w.output,
-		writer: func(anyValue any, anyOutput W) (any, W) {
-			// Convert anyValue back to type T and run the original writer function
-			convertedValue := anyValue.(T)
-			_, newOutput := w.writer(convertedValue, anyOutput)
-			return newValue, newOutput
-		},
+		value:   f(w.value),
+		output:  w.output,
+		combine: w.combine,
 	}
 }
 
 // FlatMap applies a given function that returns a new Writer monad.
-// It merges the value and the output of the original and new Writer monad
-// into a new Writer monad.
+// It returns a Writer holding the value of the new Writer monad, and the
+// output of the original one combined with the output of the new one, or the
+// output of the new one if the Writer was created with NewWriter.
 func (w writer[W, T]) FlatMap(f func(T) Writer[W, T]) Writer[W, T] {
-	newWriter := f(w.value)
-	newValue, newOutput := newWriter.Run()
+	newValue, newOutput := f(w.value).Run()
+	if w.combine != nil {
+		newOutput = w.combine.Combine(w.output, newOutput)
+	}
 	return writer[W, T]{
-		value:  newValue,
-		output: newOutput,
-		writer: w.writer,
+		value:   newValue,
+		output:  newOutput,
+		combine: w.combine,
+	}
+}
+
+// String formats the writer as Writer(value, output).
+func (w writer[W, T]) String() string {
+	return fmt.Sprint(w)
+}
+
+// GoString formats the writer as a call to NewWriter, or to NewWriterWith
+// omitting the Semigroup.
+func (w writer[W, T]) GoString() string {
+	return fmt.Sprintf("%#v", w)
+}
+
+// Format implements fmt.Formatter, applying the verb to the encapsulated value
+// and to the output, which are labeled when formatted with %+v.
+func (w writer[W, T]) Format(f fmt.State, verb rune) {
+	constructor := "NewWriter"
+	if w.combine != nil {
+		constructor = "NewWriterWith"
 	}
+	formatCase(
+		f, verb, "Writer", generic(constructor, typeName[W](), typeName[T]()),
+		labeled{label: "value", value: w.value},
+		labeled{label: "output", value: w.output},
+	)
 }
@@ -65,3 +65,44 @@ func TestWriterAssociativityLaw(t *testing.T) {
 	is.Equal(leftVal, rightVal)
 	is.Equal(leftOut, rightOut)
 }
+
+func TestWriterFlatMapReplacesOutputs(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	logs := NewWriter(1, []string{"start"}).FlatMap(func(x int) Writer[[]string, int] {
+		return NewWriter(x+1, []string{"increment"})
+	})
+	is.Equal([]string{"increment"}, logs.Output())
+	is.Equal(2, logs.Value())
+}
+
+func TestWriterWithCombinesOutputs(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	logs := NewWriterWith(1, []string{"start"}, MonoidSlice[string]()).FlatMap(func(x int) Writer[[]string, int] {
+		return NewWriter(x+1, []string{"increment"})
+	})
+	is.Equal([]string{"start", "increment"}, logs.Output())
+	is.Equal(2, logs.Value())
+
+	text := NewWriterWith(1, "a", MonoidString()).FlatMap(func(x int) Writer[string, int] {
+		return NewWriter(x, "b")
+	})
+	is.Equal("ab", text.Output())
+
+	custom := NewWriterWith(1, 5, SemigroupMax[int]()).FlatMap(func(x int) Writer[int, int] {
+		return NewWriter(x, 3)
+	})
+	is.Equal(5, custom.Output())
+}
+
+func TestWriterMapChangesType(t *testing.T) {
+	t.Parallel()
+	is := require.New(t)
+
+	val, out := NewWriter(3, "log").Map(func(x int) any { return "three" }).Run()
+	is.Equal("three", val)
+	is.Equal("log", out)
+}